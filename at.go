@@ -0,0 +1,69 @@
+package cronparse
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const atLayout = "2006-01-02T15:04:05"
+
+var (
+	ErrAtFormat = errors.New("at expression must be in the form at(yyyy-mm-ddThh:mm:ss)")
+)
+
+// at
+type AtExpression struct {
+	Time time.Time
+}
+
+// ParseAt parses a one-time schedule of Amazon EventBridge Scheduler.
+// The timestamp is interpreted in UTC.
+func ParseAt(exp string) (*AtExpression, error) {
+	if !strings.HasPrefix(exp, "at(") || !strings.HasSuffix(exp, ")") {
+		return nil, ErrAtFormat
+	}
+
+	t, err := time.Parse(atLayout, exp[len("at("):len(exp)-len(")")])
+
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrAtFormat, err)
+	}
+
+	return &AtExpression{Time: t}, nil
+}
+
+func (v *AtExpression) String() string {
+	return fmt.Sprintf("at(%s)", v.Time.Format(atLayout))
+}
+
+// In returns the expression with its timestamp read as a wall clock time in loc,
+// as EventBridge Scheduler does for a schedule with a time zone.
+// A time repeated when the clocks fall back is its first occurrence.
+func (v *AtExpression) In(loc *time.Location) *AtExpression {
+	t := v.Time
+	return &AtExpression{Time: firstOccurrence(time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, loc))}
+}
+
+func (v *AtExpression) Match(t time.Time) bool {
+	return t.Truncate(time.Minute).Equal(v.Time.Truncate(time.Minute))
+}
+
+func (v *AtExpression) Next(from time.Time) time.Time {
+	if v.Time.Before(from.Truncate(time.Minute)) {
+		return time.Time{}
+	}
+
+	return v.Time.In(from.Location())
+}
+
+func (v *AtExpression) NextN(from time.Time, n int) []time.Time {
+	schedule := []time.Time{}
+
+	if next := v.Next(from); n > 0 && !next.IsZero() {
+		schedule = append(schedule, next)
+	}
+
+	return schedule
+}
@@ -0,0 +1,95 @@
+package cronparse
+
+import (
+	"time"
+)
+
+// Between returns the trigger times at or after from and before to.
+func (v *Expression) Between(from time.Time, to time.Time) []time.Time {
+	schedule := []time.Time{}
+	it := v.Iter(from)
+
+	for t, ok := it.Next(); ok && t.Before(to); t, ok = it.Next() {
+		schedule = append(schedule, t)
+	}
+
+	return schedule
+}
+
+// Count returns the number of trigger times at or after from and before to.
+// It is equivalent to len(v.Between(from, to)) without building the slice.
+func (v *Expression) Count(from time.Time, to time.Time) int {
+	return v.Iter(from).count(to)
+}
+
+// count returns the number of the remaining triggers before to.
+// Days that lie entirely before to are counted arithmetically instead of enumerating every trigger.
+func (it *Iterator) count(to time.Time) int {
+	n := 0
+	perDay := len(it.hours) * len(it.minutes) * len(it.seconds)
+
+	for _, year := range it.years {
+		if year < it.year {
+			continue
+		}
+
+		for _, month := range it.months {
+			if year == it.year && month < it.month {
+				continue
+			}
+
+			for day := 1; day <= 31; day++ {
+				if year == it.year && month == it.month && day < it.day {
+					continue
+				}
+
+				dayOfMonth := time.Date(year, month, day, 0, 0, 0, 0, it.loc)
+
+				if dayOfMonth.Month() != month {
+					break
+				}
+
+				if !dayOfMonth.Before(to) {
+					return n
+				}
+
+				if !it.dayMatch(time.Date(year, month, day, 12, 0, 0, 0, it.loc)) {
+					continue
+				}
+
+				cursorDay := year == it.year && month == it.month && day == it.day
+
+				if !cursorDay && !time.Date(year, month, day+1, 0, 0, 0, 0, it.loc).After(to) {
+					n += perDay
+					continue
+				}
+
+				for _, hour := range it.hours {
+					if cursorDay && hour < it.hour {
+						continue
+					}
+
+					for _, minute := range it.minutes {
+						if cursorDay && hour == it.hour && minute < it.minute {
+							continue
+						}
+
+						for _, second := range it.seconds {
+							if cursorDay && hour == it.hour && minute == it.minute && second < it.second {
+								continue
+							}
+
+							if !time.Date(year, month, day, hour, minute, second, 0, it.loc).Before(to) {
+								return n
+							}
+
+							n++
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return n
+}
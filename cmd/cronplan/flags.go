@@ -7,6 +7,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 )
 
 var (
@@ -14,9 +15,16 @@ var (
 )
 
 type flags struct {
-	n    int
-	h    int
-	expr string
+	n        int
+	tz       *time.Location
+	sourceTz *time.Location
+	from     time.Time
+	to       time.Time
+	output   string
+	format   string
+	describe bool
+	lang     string
+	expr     string
 }
 
 func init() {
@@ -32,8 +40,15 @@ func init() {
 
 func parseFlags() *flags {
 	flags := &flags{}
-	flag.IntVar(&flags.h, "h", 0, "hour to add")
 	flag.IntVar(&flags.n, "n", 10, "number of next triggers")
+	from := flag.String("from", "", "time to start from, in RFC 3339 or YYYY-MM-DD (default: now)")
+	to := flag.String("to", "", "show every trigger before this time instead of the first '-n', in RFC 3339 or YYYY-MM-DD")
+	tz := flag.String("tz", "", "time zone to show triggers in, e.g. Asia/Tokyo (default: same as -source-tz)")
+	sourceTz := flag.String("source-tz", "UTC", "time zone to evaluate the expression in, as an EventBridge Scheduler schedule")
+	flag.StringVar(&flags.output, "o", outputText, "output format: "+strings.Join(outputs, ", "))
+	flag.StringVar(&flags.format, "format", "", "Go time layout to show triggers in, e.g. 2006-01-02T15:04 (not used with rfc3339 and unix)")
+	flag.BoolVar(&flags.describe, "describe", false, "print a description of the expression and exit")
+	flag.StringVar(&flags.lang, "lang", "en", "language of '-describe', e.g. ja")
 	showVersion := flag.Bool("version", false, "print version and exit")
 	flag.Parse()
 
@@ -59,9 +74,59 @@ func parseFlags() *flags {
 		log.Fatal("'-n' must be >= 1")
 	}
 
+	if !validOutput(flags.output) {
+		log.Fatalf("'-o' must be one of %s: %s", strings.Join(outputs, ", "), flags.output)
+	}
+
+	flags.sourceTz = loadLocation("-source-tz", *sourceTz)
+	flags.tz = flags.sourceTz
+
+	if *tz != "" {
+		flags.tz = loadLocation("-tz", *tz)
+	}
+
+	flags.from = time.Now()
+
+	if *from != "" {
+		flags.from = parseTime("-from", *from, flags.sourceTz)
+	}
+
+	if *to != "" {
+		flags.to = parseTime("-to", *to, flags.sourceTz)
+
+		if !flags.from.Before(flags.to) {
+			log.Fatal("'-to' must be after '-from'")
+		}
+	}
+
 	return flags
 }
 
+// parseTime parses RFC 3339 or a date, which is taken as midnight in loc.
+func parseTime(name string, s string, loc *time.Location) time.Time {
+	t, err := time.Parse(time.RFC3339, s)
+
+	if err != nil {
+		t, err = time.ParseInLocation("2006-01-02", s, loc)
+	}
+
+	if err != nil {
+		log.Fatalf("'%s' must be in RFC 3339 or YYYY-MM-DD: %s", name, s)
+	}
+
+	return t
+}
+
+func loadLocation(name string, tz string) *time.Location {
+	loc, err := time.LoadLocation(tz)
+
+	if err != nil {
+		log.Fatalf("'%s' is invalid: %s", name, err)
+	}
+
+	return loc
+}
+
 func printVersionAndExit() {
 	v := version
 
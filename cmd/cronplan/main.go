@@ -1,9 +1,13 @@
 package main
 
 import (
+	"errors"
 	"fmt"
 	"log"
+	"os"
+	"strings"
 	"time"
+	_ "time/tzdata"
 
 	"github.com/winebarrel/cronparse"
 )
@@ -14,15 +18,83 @@ func init() {
 
 func main() {
 	flags := parseFlags()
-	cron, err := cronparse.Parse(flags.expr)
+	cron, err := cronparse.ParseScheduleExpression(flags.expr)
 
 	if err != nil {
+		var perr *cronparse.ParseError
+
+		if errors.As(err, &perr) {
+			fmt.Fprintln(os.Stderr, flags.expr)
+			fmt.Fprintln(os.Stderr, strings.Repeat(" ", perr.Offset)+"^")
+		}
+
 		log.Fatal(err)
 	}
 
-	triggers := cron.NextN(time.Now(), flags.n)
+	if flags.describe {
+		d, ok := cron.(interface {
+			DescribeLocale(string) (string, error)
+		})
+
+		if !ok {
+			log.Fatal("'-describe' supports only cron expressions")
+		}
+
+		s, err := d.DescribeLocale(flags.lang)
+
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		fmt.Println(s)
+		return
+	}
+
+	next := iterate(cron, flags.from, flags.sourceTz)
+	triggers := []time.Time{}
+
+	for t, ok := next(); ok; t, ok = next() {
+		if flags.to.IsZero() {
+			if len(triggers) >= flags.n {
+				break
+			}
+		} else if !t.Before(flags.to) {
+			break
+		}
+
+		triggers = append(triggers, t)
+	}
+
+	err = printTriggers(os.Stdout, cron, triggers, flags)
+
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// iterate walks the triggers of the expression evaluated in loc as EventBridge Scheduler does.
+func iterate(exp cronparse.ScheduleExpression, from time.Time, loc *time.Location) func() (time.Time, bool) {
+	switch v := exp.(type) {
+	case *cronparse.Expression:
+		return v.IterIn(from, loc).Next
+	case *cronparse.CronExpression:
+		return v.IterIn(from, loc).Next
+	case *cronparse.AtExpression:
+		// The timestamp of at() is a wall clock time in the schedule's time zone
+		exp = v.In(loc)
+	}
+
+	from = from.In(loc)
+
+	return func() (time.Time, bool) {
+		t := exp.Next(from)
+
+		if t.IsZero() {
+			return t, false
+		}
+
+		from = t.Add(time.Minute)
 
-	for _, t := range triggers {
-		fmt.Println(t.Add(time.Duration(flags.h) * time.Hour).Format("Mon, 02 Jan 2006 15:04:05"))
+		return t, true
 	}
 }
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/winebarrel/cronparse"
+)
+
+const (
+	outputText    = "text"
+	outputJSON    = "json"
+	outputCSV     = "csv"
+	outputRFC3339 = "rfc3339"
+	outputUnix    = "unix"
+)
+
+var outputs = []string{outputText, outputJSON, outputCSV, outputRFC3339, outputUnix}
+
+func validOutput(output string) bool {
+	for _, o := range outputs {
+		if o == output {
+			return true
+		}
+	}
+
+	return false
+}
+
+const defaultLayout = "Mon, 02 Jan 2006 15:04:05 MST"
+
+type jsonOutput struct {
+	Expression     string   `json:"expression"`
+	Timezone       string   `json:"timezone"`
+	SourceTimezone string   `json:"source_timezone"`
+	Triggers       []string `json:"triggers"`
+}
+
+func printTriggers(w io.Writer, exp cronparse.ScheduleExpression, triggers []time.Time, flags *flags) error {
+	switch flags.output {
+	case outputJSON:
+		out := &jsonOutput{
+			Expression:     exp.String(),
+			Timezone:       flags.tz.String(),
+			SourceTimezone: flags.sourceTz.String(),
+			Triggers:       make([]string, 0, len(triggers)),
+		}
+
+		for _, t := range triggers {
+			out.Triggers = append(out.Triggers, t.In(flags.tz).Format(flags.layout(time.RFC3339)))
+		}
+
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(out)
+	case outputCSV:
+		records := [][]string{{"time", "unix"}}
+
+		for _, t := range triggers {
+			records = append(records, []string{t.In(flags.tz).Format(flags.layout(time.RFC3339)), strconv.FormatInt(t.Unix(), 10)})
+		}
+
+		return csv.NewWriter(w).WriteAll(records)
+	case outputUnix:
+		for _, t := range triggers {
+			fmt.Fprintln(w, t.Unix())
+		}
+	case outputRFC3339:
+		for _, t := range triggers {
+			fmt.Fprintln(w, t.In(flags.tz).Format(time.RFC3339))
+		}
+	default:
+		for _, t := range triggers {
+			fmt.Fprintln(w, t.In(flags.tz).Format(flags.layout(defaultLayout)))
+		}
+	}
+
+	return nil
+}
+
+// layout returns the '-format' layout, or def if it is not given.
+func (flags *flags) layout(def string) string {
+	if flags.format != "" {
+		return flags.format
+	}
+
+	return def
+}
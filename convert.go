@@ -0,0 +1,234 @@
+package cronparse
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/winebarrel/cronparse/utils"
+)
+
+const (
+	FormatUnixCron   = "Unix cron"
+	FormatQuartz     = "Quartz"
+	FormatKubernetes = "Kubernetes CronJob"
+)
+
+// conversion error
+type ConversionError struct {
+	Format string
+	Field  Field
+	Token  string
+	Reason string
+}
+
+func (e *ConversionError) Error() string {
+	return fmt.Sprintf("cannot convert %s %q to %s: %s", e.Field, e.Token, e.Format, e.Reason)
+}
+
+// ToUnixCron converts the expression to a 5-field Unix crontab entry.
+// Day-of-week is shifted from EventBridge (1-7, 1 is Sunday) to Unix (0-6, 0 is Sunday).
+// L, W, # and a restricted year cannot be expressed and are reported as *ConversionError.
+func (v *Expression) ToUnixCron() (string, error) {
+	return v.toUnix(FormatUnixCron)
+}
+
+// ToKubernetes converts the expression to the schedule of a Kubernetes CronJob, which uses the Unix crontab syntax.
+func (v *Expression) ToKubernetes() (string, error) {
+	return v.toUnix(FormatKubernetes)
+}
+
+// ToQuartz converts the expression to a Quartz cron expression, which fires at second 0.
+// Quartz shares the day-of-week numbering and L, W and # with EventBridge, but its years end at 2099.
+func (v *Expression) ToQuartz() (string, error) {
+	if e := quartzYearOverflow(v.Year); e != nil {
+		return "", &ConversionError{
+			Format: FormatQuartz,
+			Field:  FieldYear,
+			Token:  e.String(),
+			Reason: fmt.Sprintf("is out of range (%d-%d)", minYear, quartzMaxYear),
+		}
+	}
+
+	if v.Seconds != nil {
+		return v.String(), nil
+	}
+
+	return "0 " + v.String(), nil
+}
+
+func (v *Expression) toUnix(format string) (string, error) {
+	unsupported := func(f Field, token string, reason string) error {
+		return &ConversionError{Format: format, Field: f, Token: token, Reason: reason}
+	}
+
+	if v.Seconds != nil && v.Seconds.String() != "0" {
+		return "", unsupported(FieldSeconds, v.Seconds.String(), "seconds are not supported")
+	}
+
+	minutes := []string{}
+
+	for _, e := range v.Minutes.Exps {
+		minutes = append(minutes, e.CommonExp.unix(FieldMinutes))
+	}
+
+	hours := []string{}
+
+	for _, e := range v.Hours.Exps {
+		hours = append(hours, e.CommonExp.unix(FieldHours))
+	}
+
+	dayOfMonth := []string{}
+
+	for _, e := range v.DayOfMonth.Exps {
+		if e.Weekday != nil {
+			return "", unsupported(FieldDayOfMonth, e.String(), "'W' (nearest weekday) is not supported")
+		} else if e.Last != nil || e.LastOffset != nil {
+			return "", unsupported(FieldDayOfMonth, e.String(), "'L' (last day of the month) is not supported")
+		} else if e.LastWeekday != nil {
+			return "", unsupported(FieldDayOfMonth, e.String(), "'LW' (last weekday of the month) is not supported")
+		} else if e.Any != nil {
+			dayOfMonth = append(dayOfMonth, "*")
+		} else {
+			dayOfMonth = append(dayOfMonth, e.CommonExp.unix(FieldDayOfMonth))
+		}
+	}
+
+	month := []string{}
+
+	for _, e := range v.Month.Exps {
+		if e.CommonExp.Present() {
+			month = append(month, e.CommonExp.unix(FieldMonth))
+		} else if e.Any != nil {
+			month = append(month, "*")
+		} else if e.NameRange != nil {
+			from := utils.MonthNameToNumber(e.NameRange.From)
+			to := utils.MonthNameToNumber(e.NameRange.To)
+			month = append(month, unixWrapRange(from, to, FieldMonth.Min(), FieldMonth.Max(), unixMonthName))
+		} else if r := e.NameRangeIncrement; r != nil {
+			from := utils.MonthNameToNumber(r.From)
+			to := utils.MonthNameToNumber(r.To)
+			month = append(month, unixWrapRangeIncrement(from, to, r.Buttom, FieldMonth.Min(), FieldMonth.Max(), unixMonthName))
+		} else {
+			month = append(month, e.String())
+		}
+	}
+
+	dayOfWeek := []string{}
+
+	for _, e := range v.DayOfWeek.Exps {
+		if e.Instance != nil {
+			return "", unsupported(FieldDayOfWeek, e.String(), "'#' (nth day of the week) is not supported")
+		} else if e.LastInstance != nil {
+			return "", unsupported(FieldDayOfWeek, e.String(), "'nL' (last given day of the week of the month) is not supported")
+		} else if e.Last != nil {
+			return "", unsupported(FieldDayOfWeek, e.String(), "'L' (last day of the week) is not supported")
+		} else if e.Any != nil {
+			dayOfWeek = append(dayOfWeek, "*")
+		} else if e.Number != nil {
+			dayOfWeek = append(dayOfWeek, fmt.Sprint(e.Number.Value-1))
+		} else if e.NumberRange != nil {
+			dayOfWeek = append(dayOfWeek, unixWrapRange(e.NumberRange.From-1, e.NumberRange.To-1, 0, 6, nil))
+		} else if e.NameRange != nil {
+			from := weekNameToDayOfWeek(e.NameRange.From) - 1
+			to := weekNameToDayOfWeek(e.NameRange.To) - 1
+			dayOfWeek = append(dayOfWeek, unixWrapRange(from, to, 0, 6, unixWeekName))
+		} else if e.Increment != nil && !e.Increment.Wildcard {
+			dayOfWeek = append(dayOfWeek, fmt.Sprintf("%d-6/%d", e.Increment.Top-1, e.Increment.Buttom))
+		} else if r := e.NumberRangeIncrement; r != nil {
+			dayOfWeek = append(dayOfWeek, unixWrapRangeIncrement(r.From-1, r.To-1, r.Buttom, 0, 6, nil))
+		} else if r := e.NameRangeIncrement; r != nil {
+			from := weekNameToDayOfWeek(r.From) - 1
+			to := weekNameToDayOfWeek(r.To) - 1
+			dayOfWeek = append(dayOfWeek, unixWrapRangeIncrement(from, to, r.Buttom, 0, 6, unixWeekName))
+		} else {
+			// "*", "*/n" and names mean the same in both formats
+			dayOfWeek = append(dayOfWeek, e.String())
+		}
+	}
+
+	for _, e := range v.Year.Exps {
+		if e.All == nil {
+			return "", unsupported(FieldYear, v.Year.String(), "a restricted year is not supported")
+		}
+	}
+
+	return strings.Join([]string{
+		strings.Join(minutes, ","),
+		strings.Join(hours, ","),
+		strings.Join(dayOfMonth, ","),
+		strings.Join(month, ","),
+		strings.Join(dayOfWeek, ","),
+	}, " "), nil
+}
+
+// unix returns the common expression in the Unix crontab syntax,
+// which only allows a step after "*" or a range.
+func (v *CommonExp) unix(f Field) string {
+	if v.Increment != nil && !v.Increment.Wildcard {
+		return fmt.Sprintf("%d-%d/%d", v.Increment.Top, f.Max(), v.Increment.Buttom)
+	} else if v.NumberRange != nil {
+		return unixWrapRange(v.NumberRange.From, v.NumberRange.To, f.Min(), f.Max(), nil)
+	} else if r := v.NumberRangeIncrement; r != nil {
+		return unixWrapRangeIncrement(r.From, r.To, r.Buttom, f.Min(), f.Max(), nil)
+	}
+
+	return v.String()
+}
+
+// unixWrapRange returns a range in the Unix crontab syntax, which does not allow reversed ranges,
+// so a range such as 22-2 is split into 22-23,0-2.
+func unixWrapRange(from int, to int, min int, max int, name func(int) string) string {
+	if name == nil {
+		name = strconv.Itoa
+	}
+
+	rng := func(from int, to int) string {
+		if from == to {
+			return name(from)
+		}
+
+		return name(from) + "-" + name(to)
+	}
+
+	if from > to {
+		return rng(from, max) + "," + rng(min, to)
+	}
+
+	return rng(from, to)
+}
+
+// unixWrapRangeIncrement returns a stepped range in the Unix crontab syntax.
+// A reversed range cannot be written with a step, so 22-2/2 is listed as 22,0,2.
+func unixWrapRangeIncrement(from int, to int, step int, min int, max int, name func(int) string) string {
+	if name == nil {
+		name = strconv.Itoa
+	}
+
+	if from <= to {
+		return fmt.Sprintf("%s-%s/%d", name(from), name(to), step)
+	}
+
+	values := []string{}
+
+	for d := 0; d <= max-from+to-min+1; d += step {
+		x := from + d
+
+		if x > max {
+			x -= max - min + 1
+		}
+
+		values = append(values, name(x))
+	}
+
+	return strings.Join(values, ",")
+}
+
+func unixMonthName(n int) string {
+	return strings.ToUpper(time.Month(n).String()[:3])
+}
+
+func unixWeekName(n int) string {
+	return strings.ToUpper(time.Weekday(n).String()[:3])
+}
@@ -15,7 +15,7 @@ var (
 		{`Number`, `\d+`},
 		{`Month`, `(?i)(?:` + strings.Join(utils.MonthNames, "|") + `)`},
 		{`Week`, `(?i)(?:` + strings.Join(utils.WeekNames, "|") + `)`},
-		{`Symbol`, `[,\-\*\?/LW#]`},
+		{`Symbol`, `[,\-\*\?/LWl#]`},
 		{`SP`, `\s+`},
 	})
 
@@ -25,14 +25,17 @@ var (
 )
 
 type CommonExp struct {
-	Increment   *Increment   `@@`
-	NumberRange *NumberRange `| @@`
-	Number      *Number      `| @@`
-	All         *All         `| @@`
+	NumberRangeIncrement *NumberRangeIncrement `@@`
+	Increment            *Increment            `| @@`
+	NumberRange          *NumberRange          `| @@`
+	Number               *Number               `| @@`
+	All                  *All                  `| @@`
 }
 
 func (v *CommonExp) String() string {
-	if v.Increment != nil {
+	if v.NumberRangeIncrement != nil {
+		return v.NumberRangeIncrement.String()
+	} else if v.Increment != nil {
 		return v.Increment.String()
 	} else if v.NumberRange != nil {
 		return v.NumberRange.String()
@@ -46,12 +49,14 @@ func (v *CommonExp) String() string {
 }
 
 func (v *CommonExp) Present() bool {
-	return v.Increment != nil || v.NumberRange != nil || v.Number != nil || v.All != nil
+	return v.NumberRangeIncrement != nil || v.Increment != nil || v.NumberRange != nil || v.Number != nil || v.All != nil
 }
 
-func (v *CommonExp) Match(x int, base int) bool {
-	if v.Increment != nil {
-		return v.Increment.Match(x, base)
+func (v *CommonExp) Match(x int, f Field) bool {
+	if v.NumberRangeIncrement != nil {
+		return v.NumberRangeIncrement.Match(x, f)
+	} else if v.Increment != nil {
+		return v.Increment.Match(x, f.Min())
 	} else if v.NumberRange != nil {
 		return v.NumberRange.Match(x)
 	} else if v.Number != nil {
@@ -63,6 +68,43 @@ func (v *CommonExp) Match(x int, base int) bool {
 	return false
 }
 
+// seconds
+type SecondsExp struct {
+	CommonExp
+}
+
+func (v *SecondsExp) String() string {
+	return v.CommonExp.String()
+}
+
+func (v *SecondsExp) Match(t time.Time) bool {
+	return v.CommonExp.Match(t.Second(), FieldSeconds)
+}
+
+type Seconds struct {
+	Exps []*SecondsExp `@@ ( "," @@ )*`
+}
+
+func (v *Seconds) String() string {
+	strs := make([]string, 0, len(v.Exps))
+
+	for _, e := range v.Exps {
+		strs = append(strs, e.String())
+	}
+
+	return strings.Join(strs, ",")
+}
+
+func (v *Seconds) Match(t time.Time) bool {
+	for _, e := range v.Exps {
+		if e.Match(t) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // minutes
 type MinutesExp struct {
 	CommonExp
@@ -73,7 +115,7 @@ func (v *MinutesExp) String() string {
 }
 
 func (v *MinutesExp) Match(t time.Time) bool {
-	return v.CommonExp.Match(t.Minute(), 0)
+	return v.CommonExp.Match(t.Minute(), FieldMinutes)
 }
 
 type Minutes struct {
@@ -110,7 +152,7 @@ func (v *HoursExp) String() string {
 }
 
 func (v *HoursExp) Match(t time.Time) bool {
-	return v.CommonExp.Match(t.Hour(), 0)
+	return v.CommonExp.Match(t.Hour(), FieldHours)
 }
 
 type Hours struct {
@@ -141,8 +183,10 @@ func (v *Hours) Match(t time.Time) bool {
 type DayOfMonthExp struct {
 	Weekday *Weekday `@@ |`
 	CommonExp
-	Any  *Any         `| @@`
-	Last *LastOfMonth `| @@`
+	Any         *Any                `| @@`
+	LastWeekday *LastWeekdayOfMonth `| @@`
+	LastOffset  *LastOfMonthOffset  `| @@`
+	Last        *LastOfMonth        `| @@`
 }
 
 func (v *DayOfMonthExp) String() string {
@@ -152,6 +196,10 @@ func (v *DayOfMonthExp) String() string {
 		return v.Weekday.String()
 	} else if v.Any != nil {
 		return v.Any.String()
+	} else if v.LastWeekday != nil {
+		return v.LastWeekday.String()
+	} else if v.LastOffset != nil {
+		return v.LastOffset.String()
 	} else if v.Last != nil {
 		return v.Last.String()
 	}
@@ -161,11 +209,15 @@ func (v *DayOfMonthExp) String() string {
 
 func (v *DayOfMonthExp) Match(t time.Time) bool {
 	if v.CommonExp.Present() {
-		return v.CommonExp.Match(t.Day(), 1)
+		return v.CommonExp.Match(t.Day(), FieldDayOfMonth)
 	} else if v.Weekday != nil {
 		return v.Weekday.Match(t)
 	} else if v.Any != nil {
 		return v.Any.Match(t.Day())
+	} else if v.LastWeekday != nil {
+		return v.LastWeekday.Match(t)
+	} else if v.LastOffset != nil {
+		return v.LastOffset.Match(t)
 	} else if v.Last != nil {
 		return v.Last.Match(t)
 	}
@@ -210,14 +262,17 @@ func (v *DayOfMonth) Match(t time.Time) bool {
 // month
 type MonthExp struct {
 	CommonExp
-	NameRange *MonthRange `| @@`
-	Name      *MonthName  `| @@`
-	Any       *Any        `| @@`
+	NameRangeIncrement *MonthRangeIncrement `| @@`
+	NameRange          *MonthRange          `| @@`
+	Name               *MonthName           `| @@`
+	Any                *Any                 `| @@`
 }
 
 func (v *MonthExp) String() string {
 	if v.CommonExp.Present() {
 		return v.CommonExp.String()
+	} else if v.NameRangeIncrement != nil {
+		return v.NameRangeIncrement.String()
 	} else if v.NameRange != nil {
 		return v.NameRange.String()
 	} else if v.Name != nil {
@@ -231,7 +286,9 @@ func (v *MonthExp) String() string {
 
 func (v *MonthExp) Match(t time.Time) bool {
 	if v.CommonExp.Present() {
-		return v.CommonExp.Match(int(t.Month()), 1)
+		return v.CommonExp.Match(int(t.Month()), FieldMonth)
+	} else if v.NameRangeIncrement != nil {
+		return v.NameRangeIncrement.Match(t.Month())
 	} else if v.NameRange != nil {
 		return v.NameRange.Match(t.Month())
 	} else if v.Name != nil {
@@ -269,19 +326,25 @@ func (v *Month) Match(t time.Time) bool {
 
 // day of week
 type DayOfWeekExp struct {
-	Instance *Instance `@@ |`
+	LastInstance *LastInstance `@@ |`
+	Instance     *Instance     `@@ |`
 	CommonExp
-	NameRange *WeekRange  `| @@`
-	Name      *WeekName   `| @@`
-	Any       *Any        `| @@`
-	Last      *LastOfWeek `| @@`
+	NameRangeIncrement *WeekRangeIncrement `| @@`
+	NameRange          *WeekRange          `| @@`
+	Name               *WeekName           `| @@`
+	Any                *Any                `| @@`
+	Last               *LastOfWeek         `| @@`
 }
 
 func (v *DayOfWeekExp) String() string {
 	if v.CommonExp.Present() {
 		return v.CommonExp.String()
+	} else if v.LastInstance != nil {
+		return v.LastInstance.String()
 	} else if v.Instance != nil {
 		return v.Instance.String()
+	} else if v.NameRangeIncrement != nil {
+		return v.NameRangeIncrement.String()
 	} else if v.NameRange != nil {
 		return v.NameRange.String()
 	} else if v.Name != nil {
@@ -297,13 +360,13 @@ func (v *DayOfWeekExp) String() string {
 
 func (v *DayOfWeekExp) Match(t time.Time) bool {
 	if v.CommonExp.Present() {
-		if v.CommonExp.Increment != nil {
-			return v.CommonExp.Increment.Match(int(t.Weekday())+1, 1)
-		} else {
-			return v.CommonExp.Match(int(t.Weekday()), 0)
-		}
+		return v.CommonExp.Match(dayOfWeek(t.Weekday()), FieldDayOfWeek)
+	} else if v.LastInstance != nil {
+		return v.LastInstance.Match(t)
 	} else if v.Instance != nil {
 		return v.Instance.Match(t)
+	} else if v.NameRangeIncrement != nil {
+		return v.NameRangeIncrement.Match(t.Weekday())
 	} else if v.NameRange != nil {
 		return v.NameRange.Match(t.Weekday())
 	} else if v.Name != nil {
@@ -361,7 +424,7 @@ func (v *YearExp) String() string {
 }
 
 func (v *YearExp) Match(t time.Time) bool {
-	return v.CommonExp.Match(t.Year(), 1970)
+	return v.CommonExp.Match(t.Year(), FieldYear)
 }
 
 type Year struct {
@@ -389,19 +452,56 @@ func (v *Year) Match(t time.Time) bool {
 }
 
 type Expression struct {
+	// Seconds is only set by ParseQuartz; EventBridge expressions fire at second 0.
+	Seconds    *Seconds
 	Minutes    *Minutes    `@@`
 	Hours      *Hours      `SP @@`
 	DayOfMonth *DayOfMonth `SP @@`
 	Month      *Month      `SP @@`
 	DayOfWeek  *DayOfWeek  `SP @@`
 	Year       *Year       `SP @@`
+	// yearOmitted is set by ParseQuartz when the optional year was left out, so String leaves it out too.
+	yearOmitted bool
 }
 
 func Parse(exp string) (*Expression, error) {
-	return Parser.ParseString("", exp)
+	cron, err := Parser.ParseString("", exp)
+
+	if err != nil {
+		return nil, newParseError(eventBridgeDialect, exp, err)
+	}
+
+	err = cron.Validate()
+
+	if err != nil {
+		return nil, newParseError(eventBridgeDialect, exp, err)
+	}
+
+	return cron, nil
 }
 
 func (v *Expression) String() string {
+	if v.Seconds != nil && v.yearOmitted {
+		return fmt.Sprintf("%s %s %s %s %s %s",
+			v.Seconds.String(),
+			v.Minutes.String(),
+			v.Hours.String(),
+			v.DayOfMonth.String(),
+			v.Month.String(),
+			v.DayOfWeek.String(),
+		)
+	} else if v.Seconds != nil {
+		return fmt.Sprintf("%s %s %s %s %s %s %s",
+			v.Seconds.String(),
+			v.Minutes.String(),
+			v.Hours.String(),
+			v.DayOfMonth.String(),
+			v.Month.String(),
+			v.DayOfWeek.String(),
+			v.Year.String(),
+		)
+	}
+
 	return fmt.Sprintf("%s %s %s %s %s %s",
 		v.Minutes.String(),
 		v.Hours.String(),
@@ -413,6 +513,14 @@ func (v *Expression) String() string {
 }
 
 func (v *Expression) Match(t time.Time) bool {
+	if v.hasDayFieldConflict() {
+		return false
+	}
+
+	if v.Seconds != nil && !v.Seconds.Match(t) {
+		return false
+	}
+
 	return v.Minutes.Match(t) &&
 		v.Hours.Match(t) &&
 		v.DayOfMonth.Match(t) &&
@@ -0,0 +1,124 @@
+package cronparse_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/winebarrel/cronparse"
+)
+
+func TestParseAt(t *testing.T) {
+	assert := assert.New(t)
+	at, err := cronparse.ParseAt("at(2024-05-01T10:00:00)")
+	assert.NoError(err)
+	assert.Equal(&cronparse.AtExpression{Time: time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)}, at)
+	assert.Equal("at(2024-05-01T10:00:00)", at.String())
+
+	at, err = cronparse.ParseAt("at(2024-02-29T23:59:30)")
+	assert.NoError(err)
+	assert.Equal("at(2024-02-29T23:59:30)", at.String())
+}
+
+func TestParseAtError(t *testing.T) {
+	assert := assert.New(t)
+
+	tt := []string{
+		"at(2024-05-01)",
+		"at(2024-05-01 10:00:00)",
+		"at(2024-05-01T10:00:00Z)",
+		"at(2023-02-29T10:00:00)",
+		"at(2024-13-01T10:00:00)",
+		"at(2024-05-01T24:00:00)",
+		"at 2024-05-01T10:00:00",
+		"2024-05-01T10:00:00",
+		"at(2024-05-01T10:00:00",
+	}
+
+	for _, exp := range tt {
+		_, err := cronparse.ParseAt(exp)
+		assert.ErrorIs(err, cronparse.ErrAtFormat, exp)
+	}
+}
+
+func TestAtMatch(t *testing.T) {
+	assert := assert.New(t)
+	at, err := cronparse.ParseAt("at(2024-05-01T10:00:00)")
+	assert.NoError(err)
+
+	tt := []struct {
+		tm       time.Time
+		expected bool
+	}{
+		{time.Date(2024, 5, 1, 9, 59, 0, 0, time.UTC), false},
+		{time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC), true},
+		{time.Date(2024, 5, 1, 10, 0, 59, 0, time.UTC), true},
+		{time.Date(2024, 5, 1, 10, 1, 0, 0, time.UTC), false},
+		{time.Date(2025, 5, 1, 10, 0, 0, 0, time.UTC), false},
+		{time.Date(2024, 5, 1, 19, 0, 0, 0, time.FixedZone("JST", 9*60*60)), true},
+	}
+
+	for _, t := range tt {
+		assert.Equal(t.expected, at.Match(t.tm), t.tm)
+	}
+}
+
+func TestAtNext(t *testing.T) {
+	assert := assert.New(t)
+	at, err := cronparse.ParseAt("at(2024-05-01T10:00:00)")
+	assert.NoError(err)
+
+	tt := []struct {
+		from     time.Time
+		expected []time.Time
+	}{
+		{
+			from:     time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)},
+		},
+		{
+			from:     time.Date(2024, 5, 1, 10, 0, 30, 0, time.UTC),
+			expected: []time.Time{time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)},
+		},
+		{
+			from:     time.Date(2024, 5, 1, 10, 1, 0, 0, time.UTC),
+			expected: []time.Time{},
+		},
+	}
+
+	for _, t := range tt {
+		assert.Equal(t.expected, at.NextN(t.from, 3), t.from)
+
+		if len(t.expected) > 0 {
+			assert.Equal(t.expected[0], at.Next(t.from), t.from)
+		} else {
+			assert.Equal(time.Time{}, at.Next(t.from), t.from)
+		}
+	}
+
+	jst := time.FixedZone("JST", 9*60*60)
+	assert.Equal(time.Date(2024, 5, 1, 19, 0, 0, 0, jst), at.Next(time.Date(2024, 5, 1, 0, 0, 0, 0, jst)))
+	assert.Equal([]time.Time{}, at.NextN(time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), 0))
+}
+
+func TestAtIn(t *testing.T) {
+	assert := assert.New(t)
+	at, err := cronparse.ParseAt("at(2024-10-27T02:30:00)")
+	assert.NoError(err)
+
+	// 02:30 is repeated in Berlin; the first is 02:30 CEST
+	berlin := loadLocation(t, "Europe/Berlin")
+	assert.True(time.Date(2024, 10, 27, 0, 30, 0, 0, time.UTC).Equal(at.In(berlin).Time))
+	assert.Equal("at(2024-10-27T02:30:00)", at.In(berlin).String())
+
+	newYork := loadLocation(t, "America/New_York")
+	assert.True(time.Date(2024, 10, 27, 6, 30, 0, 0, time.UTC).Equal(at.In(newYork).Time))
+}
+
+func TestAtScheduleExpression(t *testing.T) {
+	assert := assert.New(t)
+	at, err := cronparse.ParseAt("at(2022-10-10T10:00:00)")
+	assert.NoError(err)
+	var s cronparse.ScheduleExpression = at
+	assert.Equal(time.Date(2022, 10, 10, 10, 0, 0, 0, time.UTC), s.Next(time.Date(2022, 10, 10, 9, 58, 0, 0, time.UTC)))
+}
@@ -0,0 +1,247 @@
+package cronparse_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/winebarrel/cronparse"
+)
+
+// Examples from "Cron expressions reference" in the Amazon EventBridge User Guide.
+func TestAWSDocsExamples(t *testing.T) {
+	assert := assert.New(t)
+
+	tt := []struct {
+		exp      string
+		desc     string
+		from     time.Time
+		expected []time.Time
+	}{
+		{
+			exp:  "0 10 * * ? *",
+			desc: "Run at 10:00 am (UTC+0) every day",
+			from: time.Date(2022, 10, 10, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2022, 10, 10, 10, 0, 0, 0, time.UTC),
+				time.Date(2022, 10, 11, 10, 0, 0, 0, time.UTC),
+				time.Date(2022, 10, 12, 10, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			exp:  "15 12 * * ? *",
+			desc: "Run at 12:15 pm (UTC+0) every day",
+			from: time.Date(2022, 10, 10, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2022, 10, 10, 12, 15, 0, 0, time.UTC),
+				time.Date(2022, 10, 11, 12, 15, 0, 0, time.UTC),
+				time.Date(2022, 10, 12, 12, 15, 0, 0, time.UTC),
+			},
+		},
+		{
+			exp:  "0 18 ? * MON-FRI *",
+			desc: "Run at 6:00 pm (UTC+0) every Monday through Friday",
+			from: time.Date(2022, 10, 14, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2022, 10, 14, 18, 0, 0, 0, time.UTC),
+				time.Date(2022, 10, 17, 18, 0, 0, 0, time.UTC),
+				time.Date(2022, 10, 18, 18, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			exp:  "0 8 1 * ? *",
+			desc: "Run at 8:00 am (UTC+0) every first day of the month",
+			from: time.Date(2022, 10, 10, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2022, 11, 1, 8, 0, 0, 0, time.UTC),
+				time.Date(2022, 12, 1, 8, 0, 0, 0, time.UTC),
+				time.Date(2023, 1, 1, 8, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			exp:  "0/15 * * * ? *",
+			desc: "Run every 15 minutes",
+			from: time.Date(2022, 10, 10, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2022, 10, 10, 0, 0, 0, 0, time.UTC),
+				time.Date(2022, 10, 10, 0, 15, 0, 0, time.UTC),
+				time.Date(2022, 10, 10, 0, 30, 0, 0, time.UTC),
+			},
+		},
+		{
+			exp:  "0/10 * ? * MON-FRI *",
+			desc: "Run every 10 minutes Monday through Friday",
+			from: time.Date(2022, 10, 15, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2022, 10, 17, 0, 0, 0, 0, time.UTC),
+				time.Date(2022, 10, 17, 0, 10, 0, 0, time.UTC),
+				time.Date(2022, 10, 17, 0, 20, 0, 0, time.UTC),
+			},
+		},
+		{
+			exp:  "0/5 8-17 ? * MON-FRI *",
+			desc: "Run every 5 minutes Monday through Friday between 8:00 am and 5:55 pm (UTC+0)",
+			from: time.Date(2022, 10, 14, 17, 50, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2022, 10, 14, 17, 50, 0, 0, time.UTC),
+				time.Date(2022, 10, 14, 17, 55, 0, 0, time.UTC),
+				time.Date(2022, 10, 17, 8, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			exp:  "0 9 ? * 2#1 *",
+			desc: "Run at 9 a.m. (UTC) the first Monday of each month",
+			from: time.Date(2022, 10, 10, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2022, 11, 7, 9, 0, 0, 0, time.UTC),
+				time.Date(2022, 12, 5, 9, 0, 0, 0, time.UTC),
+				time.Date(2023, 1, 2, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			exp:  "0 9 ? * 3#2 *",
+			desc: "3#2 is the second Tuesday of the month",
+			from: time.Date(2022, 10, 10, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2022, 10, 11, 9, 0, 0, 0, time.UTC),
+				time.Date(2022, 11, 8, 9, 0, 0, 0, time.UTC),
+				time.Date(2022, 12, 13, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			exp:  "0 9 ? * 2#5 *",
+			desc: "The fifth Monday only fires in months that have five Mondays",
+			from: time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2023, 5, 29, 9, 0, 0, 0, time.UTC),
+				time.Date(2023, 7, 31, 9, 0, 0, 0, time.UTC),
+				time.Date(2023, 10, 30, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			exp:  "15 10 ? * 6#3 *",
+			desc: "10:15 on the third Friday of the month",
+			from: time.Date(2022, 10, 10, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2022, 10, 21, 10, 15, 0, 0, time.UTC),
+				time.Date(2022, 11, 18, 10, 15, 0, 0, time.UTC),
+				time.Date(2022, 12, 16, 10, 15, 0, 0, time.UTC),
+			},
+		},
+		{
+			exp:  "0 9 ? * 1 *",
+			desc: "Day-of-week 1 is Sunday",
+			from: time.Date(2022, 10, 10, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2022, 10, 16, 9, 0, 0, 0, time.UTC),
+				time.Date(2022, 10, 23, 9, 0, 0, 0, time.UTC),
+				time.Date(2022, 10, 30, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			exp:  "0 9 ? * 7 *",
+			desc: "Day-of-week 7 is Saturday",
+			from: time.Date(2022, 10, 10, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2022, 10, 15, 9, 0, 0, 0, time.UTC),
+				time.Date(2022, 10, 22, 9, 0, 0, 0, time.UTC),
+				time.Date(2022, 10, 29, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			exp:  "0 9 ? * 2-6 *",
+			desc: "Day-of-week 2-6 is Monday through Friday",
+			from: time.Date(2022, 10, 14, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2022, 10, 14, 9, 0, 0, 0, time.UTC),
+				time.Date(2022, 10, 17, 9, 0, 0, 0, time.UTC),
+				time.Date(2022, 10, 18, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			exp:  "0 9 ? * */2 *",
+			desc: "Every other day of the week from Sunday",
+			from: time.Date(2022, 10, 10, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2022, 10, 11, 9, 0, 0, 0, time.UTC),
+				time.Date(2022, 10, 13, 9, 0, 0, 0, time.UTC),
+				time.Date(2022, 10, 15, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			exp:  "0 9 ? * FRI-MON *",
+			desc: "A range of names may wrap around the end of the week",
+			from: time.Date(2022, 10, 11, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2022, 10, 14, 9, 0, 0, 0, time.UTC),
+				time.Date(2022, 10, 15, 9, 0, 0, 0, time.UTC),
+				time.Date(2022, 10, 16, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			exp:  "0 9 ? * L *",
+			desc: "L in day-of-week is the last day of the week",
+			from: time.Date(2022, 10, 10, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2022, 10, 15, 9, 0, 0, 0, time.UTC),
+				time.Date(2022, 10, 22, 9, 0, 0, 0, time.UTC),
+				time.Date(2022, 10, 29, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			exp:  "0 9 L * ? *",
+			desc: "L in day-of-month is the last day of the month",
+			from: time.Date(2022, 10, 10, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2022, 10, 31, 9, 0, 0, 0, time.UTC),
+				time.Date(2022, 11, 30, 9, 0, 0, 0, time.UTC),
+				time.Date(2022, 12, 31, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			exp:  "0 9 3W * ? *",
+			desc: "3W is the weekday closest to the third day of the month",
+			from: time.Date(2022, 10, 10, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2022, 11, 3, 9, 0, 0, 0, time.UTC),
+				time.Date(2022, 12, 2, 9, 0, 0, 0, time.UTC),
+				time.Date(2023, 1, 3, 9, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	for _, t := range tt {
+		cron, err := cronparse.Parse(t.exp)
+		assert.NoError(err, t.exp)
+		assert.Equal(t.expected, cron.NextN(t.from, len(t.expected)), t.desc)
+
+		schedule, err := cron.Compile()
+		assert.NoError(err, t.exp)
+		assert.Equal(t.expected, schedule.NextN(t.from, len(t.expected)), t.desc)
+	}
+}
+
+func TestDayOfWeekNumbersAndNames(t *testing.T) {
+	assert := assert.New(t)
+	names := []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}
+	// Every day from 2022-10-08 to 2022-10-14 is the second of its day of the week in the month
+	start := time.Date(2022, 10, 8, 0, 0, 0, 0, time.UTC)
+
+	for n, name := range names {
+		number, err := cronparse.Parse(fmt.Sprintf("0 0 ? * %d *", n+1))
+		assert.NoError(err)
+		named, err := cronparse.Parse(fmt.Sprintf("0 0 ? * %s *", name))
+		assert.NoError(err)
+		instance, err := cronparse.Parse(fmt.Sprintf("0 0 ? * %d#2 *", n+1))
+		assert.NoError(err)
+
+		for d := 0; d < 7; d++ {
+			tm := start.AddDate(0, 0, d)
+			expected := tm.Weekday() == time.Weekday(n)
+			assert.Equal(expected, number.Match(tm), fmt.Sprintf("%d %s", n+1, tm))
+			assert.Equal(expected, named.Match(tm), fmt.Sprintf("%s %s", name, tm))
+			assert.Equal(expected, instance.Match(tm), fmt.Sprintf("%d#2 %s", n+1, tm))
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package cronparse_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/winebarrel/cronparse"
+)
+
+func TestBetween(t *testing.T) {
+	assert := assert.New(t)
+
+	tt := []struct {
+		exp      string
+		from     time.Time
+		to       time.Time
+		expected []time.Time
+	}{
+		{
+			exp:  "0 10 L * ? *",
+			from: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			to:   time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2024, 1, 31, 10, 0, 0, 0, time.UTC),
+				time.Date(2024, 2, 29, 10, 0, 0, 0, time.UTC),
+				time.Date(2024, 3, 31, 10, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			exp:  "0/20 10 ? * FRI *",
+			from: time.Date(2022, 10, 14, 10, 20, 0, 0, time.UTC),
+			to:   time.Date(2022, 10, 21, 10, 40, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2022, 10, 14, 10, 20, 0, 0, time.UTC),
+				time.Date(2022, 10, 14, 10, 40, 0, 0, time.UTC),
+				time.Date(2022, 10, 21, 10, 0, 0, 0, time.UTC),
+				time.Date(2022, 10, 21, 10, 20, 0, 0, time.UTC),
+			},
+		},
+		{
+			exp:      "0 10 * * ? *",
+			from:     time.Date(2022, 10, 10, 10, 1, 0, 0, time.UTC),
+			to:       time.Date(2022, 10, 11, 10, 0, 0, 0, time.UTC),
+			expected: []time.Time{},
+		},
+		{
+			exp:  "35 9 17 DEC ? 2022,2023",
+			from: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+			to:   time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2022, 12, 17, 9, 35, 0, 0, time.UTC),
+				time.Date(2023, 12, 17, 9, 35, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	for _, t := range tt {
+		cron, err := cronparse.Parser.ParseString("", t.exp)
+		assert.NoError(err)
+		assert.Equal(t.expected, cron.Between(t.from, t.to), t)
+	}
+}
+
+func TestCount(t *testing.T) {
+	assert := assert.New(t)
+
+	tt := []struct {
+		exp      string
+		from     time.Time
+		to       time.Time
+		expected int
+	}{
+		{
+			exp:      "*/5 * * * ? *",
+			from:     time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+			to:       time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: 365 * 24 * 12,
+		},
+		{
+			exp:      "*/5 * * * ? *",
+			from:     time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			to:       time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: 366 * 24 * 12,
+		},
+		{
+			exp:      "* * * * ? *",
+			from:     time.Date(2022, 10, 10, 10, 30, 0, 0, time.UTC),
+			to:       time.Date(2022, 10, 10, 11, 0, 0, 0, time.UTC),
+			expected: 30,
+		},
+		{
+			exp:      "0 9-17 ? * MON-FRI *",
+			from:     time.Date(2022, 10, 1, 0, 0, 0, 0, time.UTC),
+			to:       time.Date(2022, 11, 1, 0, 0, 0, 0, time.UTC),
+			expected: 21 * 9,
+		},
+		{
+			exp:      "0 10 * * ? 2020",
+			from:     time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+			to:       time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: 0,
+		},
+		{
+			exp:      "0 10 * * * *",
+			from:     time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+			to:       time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: 0,
+		},
+	}
+
+	for _, t := range tt {
+		cron, err := cronparse.Parser.ParseString("", t.exp)
+		assert.NoError(err)
+		assert.Equal(t.expected, cron.Count(t.from, t.to), t)
+	}
+}
+
+func TestCountMatchesBetween(t *testing.T) {
+	assert := assert.New(t)
+
+	exps := []string{
+		"*/7 */3 * * ? *",
+		"0/15 9-17 ? * MON-FRI *",
+		"0 10 L * ? *",
+		"15 10 ? * 6#3 *",
+		"0 12 3W * ? *",
+		"30 23 1,15 JAN-JUN ? *",
+	}
+
+	windows := [][2]time.Time{
+		{time.Date(2022, 10, 10, 5, 32, 0, 0, time.UTC), time.Date(2022, 12, 3, 17, 5, 0, 0, time.UTC)},
+		{time.Date(2022, 12, 31, 23, 59, 30, 0, time.UTC), time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2022, 10, 10, 0, 0, 0, 0, time.UTC), time.Date(2022, 10, 10, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2022, 10, 10, 0, 0, 0, 0, time.UTC), time.Date(2022, 10, 9, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, exp := range exps {
+		cron, err := cronparse.Parser.ParseString("", exp)
+		assert.NoError(err)
+
+		for _, w := range windows {
+			assert.Equal(len(cron.Between(w[0], w[1])), cron.Count(w[0], w[1]), exp, w)
+		}
+	}
+}
@@ -0,0 +1,177 @@
+package cronparse_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/winebarrel/cronparse"
+)
+
+func TestToUnixCron(t *testing.T) {
+	assert := assert.New(t)
+
+	tt := []struct {
+		exp      string
+		expected string
+	}{
+		{"* * * * ? *", "* * * * *"},
+		{"0 10 * * ? *", "0 10 * * *"},
+		{"*/5 9-17 1,15 JAN-MAR ? *", "*/5 9-17 1,15 JAN-MAR *"},
+		{"10/20 3/4 2/5 2/3 ? *", "10-59/20 3-23/4 2-31/5 2-12/3 *"},
+		{"0 9 ? * 1 *", "0 9 * * 0"},
+		{"0 9 ? * 7 *", "0 9 * * 6"},
+		{"0 9 ? * 2-6 *", "0 9 * * 1-5"},
+		{"0 9 ? * 2,4,6 *", "0 9 * * 1,3,5"},
+		{"0 9 ? * MON-FRI *", "0 9 * * MON-FRI"},
+		{"0 9 ? * */2 *", "0 9 * * */2"},
+		{"0 9 ? * 2/2 *", "0 9 * * 1-6/2"},
+		{"0 9 ? * * *", "0 9 * * *"},
+		{"0/30 22-2 ? * FRI-MON *", "0-59/30 22-23,0-2 * * FRI-SAT,SUN-MON"},
+		{"0 9 28-2 NOV-FEB ? *", "0 9 28-31,1-2 NOV-DEC,JAN-FEB *"},
+		{"0 9 1 DEC-JAN ? *", "0 9 1 DEC,JAN *"},
+		{"0 9 ? * 6-2 *", "0 9 * * 5-6,0-1"},
+		{"0 9 ? * SAT-SUN *", "0 9 * * SAT,SUN"},
+		{"10-40/5 9-17/2 1-15/7 FEB-NOV/3 ? *", "10-40/5 9-17/2 1-15/7 FEB-NOV/3 *"},
+		{"0 9 ? * 2-6/2 *", "0 9 * * 1-5/2"},
+		{"0 9 ? * MON-FRI/2 *", "0 9 * * MON-FRI/2"},
+		{"0 22-2/2 * * ? *", "0 22,0,2 * * *"},
+		{"0 9 1 NOV-FEB/2 ? *", "0 9 1 NOV,JAN *"},
+		{"0 9 ? * MON-SUN/2 *", "0 9 * * MON,WED,FRI,SUN"},
+		{"0 9 ? * 6-2/2 *", "0 9 * * 5,0"},
+	}
+
+	for _, t := range tt {
+		cron, err := cronparse.Parse(t.exp)
+		assert.NoError(err)
+
+		for _, f := range []func() (string, error){cron.ToUnixCron, cron.ToKubernetes} {
+			s, err := f()
+
+			if assert.NoError(err, t.exp) {
+				assert.Equal(t.expected, s, t.exp)
+			}
+		}
+	}
+}
+
+func TestToUnixCronRoundTrip(t *testing.T) {
+	assert := assert.New(t)
+
+	tt := []string{
+		"*/5 9-17 1,15 JAN-MAR ? *",
+		"0 9 ? * 1 *",
+		"0 9 ? * 2-6 *",
+		"0 9 ? * 2,4,6 *",
+		"0 9 ? * MON-FRI *",
+	}
+
+	for _, exp := range tt {
+		cron, err := cronparse.Parse(exp)
+		assert.NoError(err)
+		s, err := cron.ToUnixCron()
+		assert.NoError(err)
+		cron, err = cronparse.FromUnixCron(s)
+
+		if assert.NoError(err, exp) {
+			assert.Equal(exp, cron.String())
+		}
+	}
+}
+
+func TestToUnixCronError(t *testing.T) {
+	assert := assert.New(t)
+
+	tt := []struct {
+		exp      string
+		field    cronparse.Field
+		token    string
+		expected string
+	}{
+		{"0 10 L * ? *", cronparse.FieldDayOfMonth, "L", `cannot convert DayOfMonth "L" to Unix cron: 'L' (last day of the month) is not supported`},
+		{"0 10 L-3 * ? *", cronparse.FieldDayOfMonth, "L-3", `cannot convert DayOfMonth "L-3" to Unix cron: 'L' (last day of the month) is not supported`},
+		{"0 10 LW * ? *", cronparse.FieldDayOfMonth, "LW", `cannot convert DayOfMonth "LW" to Unix cron: 'LW' (last weekday of the month) is not supported`},
+		{"0 10 1,15W * ? *", cronparse.FieldDayOfMonth, "15W", `cannot convert DayOfMonth "15W" to Unix cron: 'W' (nearest weekday) is not supported`},
+		{"0 10 ? * 6#3 *", cronparse.FieldDayOfWeek, "6#3", `cannot convert DayOfWeek "6#3" to Unix cron: '#' (nth day of the week) is not supported`},
+		{"0 10 ? * 6L *", cronparse.FieldDayOfWeek, "6L", `cannot convert DayOfWeek "6L" to Unix cron: 'nL' (last given day of the week of the month) is not supported`},
+		{"0 10 ? * L *", cronparse.FieldDayOfWeek, "L", `cannot convert DayOfWeek "L" to Unix cron: 'L' (last day of the week) is not supported`},
+		{"0 10 * * ? 2024", cronparse.FieldYear, "2024", `cannot convert Year "2024" to Unix cron: a restricted year is not supported`},
+		{"0 10 * * ? 2024,*", cronparse.FieldYear, "2024,*", `cannot convert Year "2024,*" to Unix cron: a restricted year is not supported`},
+	}
+
+	for _, t := range tt {
+		cron, err := cronparse.Parse(t.exp)
+		assert.NoError(err)
+		_, err = cron.ToUnixCron()
+		var cerr *cronparse.ConversionError
+
+		if assert.True(errors.As(err, &cerr), t.exp) {
+			assert.Equal(cronparse.FormatUnixCron, cerr.Format)
+			assert.Equal(t.field, cerr.Field, t.exp)
+			assert.Equal(t.token, cerr.Token, t.exp)
+			assert.Equal(t.expected, cerr.Error(), t.exp)
+		}
+	}
+
+	cron, err := cronparse.Parse("0 10 L * ? *")
+	assert.NoError(err)
+	_, err = cron.ToKubernetes()
+	assert.EqualError(err, `cannot convert DayOfMonth "L" to Kubernetes CronJob: 'L' (last day of the month) is not supported`)
+}
+
+func TestToQuartz(t *testing.T) {
+	assert := assert.New(t)
+
+	tt := []struct {
+		exp      string
+		expected string
+	}{
+		{"0 10 * * ? *", "0 0 10 * * ? *"},
+		{"0/15 9-17 ? * MON-FRI *", "0 0/15 9-17 ? * MON-FRI *"},
+		{"0 10 L * ? *", "0 0 10 L * ? *"},
+		{"0 10 15W * ? *", "0 0 10 15W * ? *"},
+		{"0 10 LW * ? *", "0 0 10 LW * ? *"},
+		{"0 10 L-3 * ? *", "0 0 10 L-3 * ? *"},
+		{"0 10 ? * 6#3 *", "0 0 10 ? * 6#3 *"},
+		{"0 10 ? * 6L *", "0 0 10 ? * 6L *"},
+		{"0 10 ? * FRIL *", "0 0 10 ? * FRIL *"},
+		{"0 10 ? * 1 2024-2099", "0 0 10 ? * 1 2024-2099"},
+		{"0 10 * * ? */10", "0 0 10 * * ? */10"},
+	}
+
+	for _, t := range tt {
+		cron, err := cronparse.Parse(t.exp)
+		assert.NoError(err)
+		s, err := cron.ToQuartz()
+
+		if assert.NoError(err, t.exp) {
+			assert.Equal(t.expected, s, t.exp)
+		}
+	}
+}
+
+func TestToQuartzError(t *testing.T) {
+	assert := assert.New(t)
+
+	tt := []struct {
+		exp      string
+		expected string
+	}{
+		{"0 10 * * ? 2100", `cannot convert Year "2100" to Quartz: is out of range (1970-2099)`},
+		{"0 10 * * ? 2024-2150", `cannot convert Year "2024-2150" to Quartz: is out of range (1970-2099)`},
+		{"0 10 * * ? 2024,2199/5", `cannot convert Year "2199/5" to Quartz: is out of range (1970-2099)`},
+		{"0 10 * * ? 2090-2110/5", `cannot convert Year "2090-2110/5" to Quartz: is out of range (1970-2099)`},
+	}
+
+	for _, t := range tt {
+		cron, err := cronparse.Parse(t.exp)
+		assert.NoError(err)
+		_, err = cron.ToQuartz()
+		var cerr *cronparse.ConversionError
+
+		if assert.True(errors.As(err, &cerr), t.exp) {
+			assert.Equal(cronparse.FieldYear, cerr.Field)
+			assert.Equal(t.expected, cerr.Error(), t.exp)
+		}
+	}
+}
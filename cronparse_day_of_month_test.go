@@ -56,6 +56,20 @@ func TestDayOfMonthLast(t *testing.T) {
 	assert.Equal(&cronparse.LastOfMonth{}, cron.DayOfMonth.Exps[0].Last)
 }
 
+func TestDayOfMonthLastWeekday(t *testing.T) {
+	assert := assert.New(t)
+	cron, err := cronparse.Parser.ParseString("", "* * LW * ? *")
+	assert.NoError(err)
+	assert.Equal(&cronparse.LastWeekdayOfMonth{}, cron.DayOfMonth.Exps[0].LastWeekday)
+}
+
+func TestDayOfMonthLastOffset(t *testing.T) {
+	assert := assert.New(t)
+	cron, err := cronparse.Parser.ParseString("", "* * L-3 * ? *")
+	assert.NoError(err)
+	assert.Equal(&cronparse.LastOfMonthOffset{Offset: 3}, cron.DayOfMonth.Exps[0].LastOffset)
+}
+
 func TestDayOfMonthWeekday(t *testing.T) {
 	assert := assert.New(t)
 	cron, err := cronparse.Parser.ParseString("", "* * 3W * ? *")
@@ -65,7 +79,7 @@ func TestDayOfMonthWeekday(t *testing.T) {
 
 func TestDayOfMonthComplex(t *testing.T) {
 	assert := assert.New(t)
-	cron, err := cronparse.Parser.ParseString("", "* * *,1,1-30,1/5,*/5,?,L,3W * ? *")
+	cron, err := cronparse.Parser.ParseString("", "* * *,1,1-30,1/5,*/5,?,L,3W,LW,L-3 * ? *")
 	assert.NoError(err)
 	assert.Equal(&cronparse.All{}, cron.DayOfMonth.Exps[0].All)
 	assert.Equal(&cronparse.Number{Value: 1}, cron.DayOfMonth.Exps[1].Number)
@@ -75,4 +89,6 @@ func TestDayOfMonthComplex(t *testing.T) {
 	assert.Equal(&cronparse.Any{}, cron.DayOfMonth.Exps[5].Any)
 	assert.Equal(&cronparse.LastOfMonth{}, cron.DayOfMonth.Exps[6].Last)
 	assert.Equal(&cronparse.Weekday{Value: 3}, cron.DayOfMonth.Exps[7].Weekday)
+	assert.Equal(&cronparse.LastWeekdayOfMonth{}, cron.DayOfMonth.Exps[8].LastWeekday)
+	assert.Equal(&cronparse.LastOfMonthOffset{Offset: 3}, cron.DayOfMonth.Exps[9].LastOffset)
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/winebarrel/cronparse"
@@ -58,6 +59,32 @@ func TestDayOfWeekLast(t *testing.T) {
 	assert.Equal(&cronparse.LastOfWeek{}, cron.DayOfWeek.Exps[0].Last)
 }
 
+func TestDayOfWeekLastInstance(t *testing.T) {
+	assert := assert.New(t)
+	cron, err := cronparse.Parser.ParseString("", "* * ? * 6L *")
+	assert.NoError(err)
+	assert.Equal(&cronparse.LastInstance{DayOfWeek: 6}, cron.DayOfWeek.Exps[0].LastInstance)
+}
+
+func TestDayOfWeekLastInstanceName(t *testing.T) {
+	assert := assert.New(t)
+	cron, err := cronparse.Parser.ParseString("", "* * ? * FRIL *")
+	assert.NoError(err)
+	assert.Equal(&cronparse.LastInstance{Name: "FRI"}, cron.DayOfWeek.Exps[0].LastInstance)
+}
+
+func TestDayOfWeekLastInstanceLowercase(t *testing.T) {
+	assert := assert.New(t)
+	cron, err := cronparse.Parser.ParseString("", "* * ? * fril,6l *")
+	assert.NoError(err)
+	assert.Equal(&cronparse.LastInstance{Name: "fri"}, cron.DayOfWeek.Exps[0].LastInstance)
+	assert.Equal(&cronparse.LastInstance{DayOfWeek: 6}, cron.DayOfWeek.Exps[1].LastInstance)
+
+	exp, err := cronparse.Parse("0 9 ? * fril *")
+	assert.NoError(err)
+	assert.Equal(time.Date(2022, 10, 28, 9, 0, 0, 0, time.UTC), exp.Next(time.Date(2022, 10, 1, 0, 0, 0, 0, time.UTC)))
+}
+
 func TestDayOfWeekName(t *testing.T) {
 	assert := assert.New(t)
 	tt := []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}
@@ -80,9 +107,16 @@ func TestDayOfWeekNameRange(t *testing.T) {
 	assert.Equal(&cronparse.WeekRange{From: "SUN", To: "SAT"}, cron.DayOfWeek.Exps[0].NameRange)
 }
 
+func TestDayOfWeekNameRangeIncrement(t *testing.T) {
+	assert := assert.New(t)
+	cron, err := cronparse.Parser.ParseString("", "* * ? * MON-FRI/2 *")
+	assert.NoError(err)
+	assert.Equal(&cronparse.WeekRangeIncrement{From: "MON", To: "FRI", Buttom: 2}, cron.DayOfWeek.Exps[0].NameRangeIncrement)
+}
+
 func TestDayOfWeekComplex(t *testing.T) {
 	assert := assert.New(t)
-	cron, err := cronparse.Parser.ParseString("", "* * ? * *,1,1-7,1/5,*/5,?,L,SUN,SUN-SAT *")
+	cron, err := cronparse.Parser.ParseString("", "* * ? * *,1,1-7,1/5,*/5,?,L,SUN,SUN-SAT,2-6/2,MON-FRI/2,6L,FRIL *")
 	assert.NoError(err)
 	assert.Equal(&cronparse.All{}, cron.DayOfWeek.Exps[0].All)
 	assert.Equal(&cronparse.Number{Value: 1}, cron.DayOfWeek.Exps[1].Number)
@@ -93,4 +127,8 @@ func TestDayOfWeekComplex(t *testing.T) {
 	assert.Equal(&cronparse.LastOfWeek{}, cron.DayOfWeek.Exps[6].Last)
 	assert.Equal(&cronparse.WeekName{Value: "SUN"}, cron.DayOfWeek.Exps[7].Name)
 	assert.Equal(&cronparse.WeekRange{From: "SUN", To: "SAT"}, cron.DayOfWeek.Exps[8].NameRange)
+	assert.Equal(&cronparse.NumberRangeIncrement{From: 2, To: 6, Buttom: 2}, cron.DayOfWeek.Exps[9].NumberRangeIncrement)
+	assert.Equal(&cronparse.WeekRangeIncrement{From: "MON", To: "FRI", Buttom: 2}, cron.DayOfWeek.Exps[10].NameRangeIncrement)
+	assert.Equal(&cronparse.LastInstance{DayOfWeek: 6}, cron.DayOfWeek.Exps[11].LastInstance)
+	assert.Equal(&cronparse.LastInstance{Name: "FRI"}, cron.DayOfWeek.Exps[12].LastInstance)
 }
@@ -0,0 +1,141 @@
+package cronparse_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/winebarrel/cronparse"
+)
+
+func TestDescribe(t *testing.T) {
+	assert := assert.New(t)
+
+	tt := []struct {
+		exp      string
+		expected string
+	}{
+		{"0/15 9-17 ? * MON-FRI *", "Every 15 minutes, between 09:00 and 17:59, Monday through Friday"},
+		{"0 10 * * ? *", "At 10:00"},
+		{"15,45 9,17 * * ? *", "At 09:15, 09:45, 17:15 and 17:45"},
+		{"* * * * ? *", "Every minute"},
+		{"30 * * * ? *", "At minute 30 past the hour"},
+		{"0,30 */2 * * ? *", "At minutes 0 and 30 past the hour, every 2 hours"},
+		{"10/20 3/4 * * ? *", "Every 20 minutes starting at minute 10, every 4 hours starting at 03:00"},
+		{"0-29 12 * * ? *", "Every minute from 0 through 29 past the hour, between 12:00 and 12:59"},
+		{"0 0 1,15 * ? *", "At 00:00, on days 1 and 15 of the month"},
+		{"0 0 L * ? *", "At 00:00, on the last day of the month"},
+		{"0 0 15W * ? *", "At 00:00, on the weekday nearest day 15 of the month"},
+		{"0 0 LW * ? *", "At 00:00, on the last weekday of the month"},
+		{"0 0 L-1 * ? *", "At 00:00, on the day before the last day of the month"},
+		{"0 0 L-3 * ? *", "At 00:00, on the day 3 days before the last day of the month"},
+		{"0 0 1-7 * ? *", "At 00:00, between day 1 and 7 of the month"},
+		{"0 0 2/5 * ? *", "At 00:00, every 5 days starting on day 2 of the month"},
+		{"0 0 ? * 2,FRI *", "At 00:00, only on Monday and Friday"},
+		{"0 0 ? * 2-6 *", "At 00:00, Monday through Friday"},
+		{"0 0 ? * 6#3 *", "At 00:00, on the third Friday of the month"},
+		{"0 0 ? * L *", "At 00:00, on the last day of the week"},
+		{"0 0 ? * 6L *", "At 00:00, on the last Friday of the month"},
+		{"0 0 ? * MONL *", "At 00:00, on the last Monday of the month"},
+		{"0 0 ? * */2 *", "At 00:00, every 2 days of the week"},
+		{"10-40/15 9-17/2 * * ? *", "Every 15 minutes from 10 through 40 past the hour, every 2 hours between 09:00 and 17:59"},
+		{"0 0 1-15/7 FEB-NOV/3 ? 2024-2030/2", "At 00:00, every 7 days between day 1 and 15 of the month, every 3 months from February through November, every 2 years from 2024 through 2030"},
+		{"0 0 ? * MON-FRI/2 *", "At 00:00, every 2 days of the week from Monday through Friday"},
+		{"0 22-2 ? * FRI-MON *", "At minute 0 past the hour, between 22:00 and 02:59, Friday through Monday"},
+		{"0 0 1 JAN,7 ? *", "At 00:00, on day 1 of the month, only in January and July"},
+		{"0 0 1 JAN-MAR ? *", "At 00:00, on day 1 of the month, January through March"},
+		{"0 0 1 2/3 ? *", "At 00:00, on day 1 of the month, every 3 months starting in February"},
+		{"0 0 1 1 ? 2024", "At 00:00, on day 1 of the month, only in January, only in 2024"},
+		{"0 0 1 1 ? 2024-2026", "At 00:00, on day 1 of the month, only in January, 2024 through 2026"},
+		{"0 0 1 1 ? 2000/5", "At 00:00, on day 1 of the month, only in January, every 5 years starting in 2000"},
+	}
+
+	for _, t := range tt {
+		cron, err := cronparse.Parse(t.exp)
+		assert.NoError(err)
+		assert.Equal(t.expected, cron.Describe(), t.exp)
+	}
+}
+
+func TestDescribeLocaleJapanese(t *testing.T) {
+	assert := assert.New(t)
+
+	tt := []struct {
+		exp      string
+		expected string
+	}{
+		{"0/15 9-17 ? * MON-FRI *", "月曜日から金曜日まで、09:00から17:59の間、15分ごと"},
+		{"0 10 * * ? *", "10:00に"},
+		{"0 9,17 ? * 6#3 *", "毎月第3金曜日、09:00と17:00に"},
+		{"0 0 L * ? *", "毎月末日、00:00に"},
+		{"0 0 15W * ? *", "毎月15日に最も近い平日、00:00に"},
+		{"0 18 LW * ? *", "毎月最終平日、18:00に"},
+		{"0 18 ? * 6L *", "毎月最終金曜日、18:00に"},
+		{"0 18 L-3 * ? *", "毎月末日の3日前、18:00に"},
+		{"30 * 1,15 JAN,MAR,5 ? 2024", "2024年のみ、1月、3月と5月のみ、毎月1と15日、毎時30分"},
+		{"10/20 3/4 * * ? *", "03:00から4時間ごと、10分から20分ごと"},
+		{"0 0 ? * 2,4 *", "毎週月曜日と水曜日、00:00に"},
+		{"10-40/15 9-17/2 ? * MON-FRI/2 *", "月曜日から金曜日まで2日ごと、09:00から17:59の間2時間ごと、毎時10分から40分まで15分ごと"},
+	}
+
+	for _, t := range tt {
+		cron, err := cronparse.Parse(t.exp)
+		assert.NoError(err)
+		s, err := cron.DescribeLocale("ja")
+		assert.NoError(err)
+		assert.Equal(t.expected, s, t.exp)
+	}
+}
+
+func TestDescribeLocaleRegion(t *testing.T) {
+	assert := assert.New(t)
+	cron, err := cronparse.Parse("0 10 * * ? *")
+	assert.NoError(err)
+
+	for _, lang := range []string{"en", "EN", "en-US", "en_GB"} {
+		s, err := cron.DescribeLocale(lang)
+		assert.NoError(err)
+		assert.Equal("At 10:00", s, lang)
+	}
+
+	var s string
+
+	s, err = cron.DescribeLocale("ja-JP")
+	assert.NoError(err)
+	assert.Equal("10:00に", s)
+}
+
+func TestDescribeLocaleUnknown(t *testing.T) {
+	assert := assert.New(t)
+	cron, err := cronparse.Parse("0 10 * * ? *")
+	assert.NoError(err)
+	_, err = cron.DescribeLocale("xx")
+	assert.ErrorIs(err, cronparse.ErrUnknownLocale)
+	assert.EqualError(err, "unknown locale: xx")
+}
+
+func TestRegisterLocale(t *testing.T) {
+	assert := assert.New(t)
+
+	cronparse.RegisterLocale("de", &cronparse.Locale{
+		Messages: map[cronparse.Message]string{
+			cronparse.MsgAt:         "um %s",
+			cronparse.MsgNthWeekday: "am %s %s des Monats",
+		},
+		Weekdays: [7]string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"},
+		Ordinal: func(n int) string {
+			return []string{"ersten", "zweiten", "dritten", "vierten", "fünften"}[n-1]
+		},
+		Join: func(items []string) string {
+			return strings.Join(items, " und ")
+		},
+		Capitalize: true,
+	})
+
+	cron, err := cronparse.Parse("0 9,17 ? * 6#3 *")
+	assert.NoError(err)
+	s, err := cron.DescribeLocale("de")
+	assert.NoError(err)
+	// The separator falls back to English
+	assert.Equal("Um 09:00 und 17:00, am dritten Freitag des Monats", s)
+}
@@ -0,0 +1,175 @@
+package cronparse_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/winebarrel/cronparse"
+)
+
+func TestParseError(t *testing.T) {
+	assert := assert.New(t)
+
+	tt := []struct {
+		exp      string
+		offset   int
+		field    cronparse.Field
+		token    string
+		hint     string
+		expected string
+	}{
+		{
+			exp:      "0 10 ? * X *",
+			offset:   9,
+			field:    cronparse.FieldDayOfWeek,
+			token:    "X",
+			hint:     "day-of-week must be 1-7 (1 is SUN), L, nL or n#m; day-of-week names are SUN..SAT",
+			expected: `column 10 (DayOfWeek): unexpected token "X"; day-of-week must be 1-7 (1 is SUN), L, nL or n#m; day-of-week names are SUN..SAT`,
+		},
+		{
+			exp:      "0 10 ? * MONX *",
+			offset:   12,
+			field:    cronparse.FieldDayOfWeek,
+			token:    "X",
+			hint:     "day-of-week must be 1-7 (1 is SUN), L, nL or n#m; day-of-week names are SUN..SAT",
+			expected: `column 13 (DayOfWeek): unexpected token "X"; day-of-week must be 1-7 (1 is SUN), L, nL or n#m; day-of-week names are SUN..SAT`,
+		},
+		{
+			exp:      "0 10 * FOO ? *",
+			offset:   7,
+			field:    cronparse.FieldMonth,
+			token:    "FOO",
+			hint:     "month must be 1-12; month names are JAN..DEC",
+			expected: `column 8 (Month): unexpected token "FOO"; month must be 1-12; month names are JAN..DEC`,
+		},
+		{
+			exp:      "0 10 1-W * ? *",
+			offset:   7,
+			field:    cronparse.FieldDayOfMonth,
+			token:    "W",
+			hint:     "day-of-month must be 1-31, L, L-n, LW or nW",
+			expected: `column 8 (DayOfMonth): unexpected token "W"; day-of-month must be 1-31, L, L-n, LW or nW`,
+		},
+		{
+			exp:      "0-30/X 10 * * ? *",
+			offset:   5,
+			field:    cronparse.FieldMinutes,
+			token:    "X",
+			hint:     "minutes must be 0-59",
+			expected: `column 6 (Minutes): unexpected token "X"; minutes must be 0-59`,
+		},
+		{
+			exp:      "0 10 * *",
+			offset:   8,
+			field:    cronparse.FieldDayOfWeek,
+			token:    "",
+			hint:     "an expression has 6 fields: minutes hours day-of-month month day-of-week year",
+			expected: `column 9 (DayOfWeek): unexpected end of expression; an expression has 6 fields: minutes hours day-of-month month day-of-week year`,
+		},
+		{
+			exp:      "0 10 * * ? * *",
+			offset:   13,
+			field:    cronparse.Field(-1),
+			token:    "*",
+			hint:     "an expression has 6 fields: minutes hours day-of-month month day-of-week year",
+			expected: `column 14: unexpected token "*"; an expression has 6 fields: minutes hours day-of-month month day-of-week year`,
+		},
+		{
+			exp:      "0 10 * * ? *  ",
+			offset:   12,
+			field:    cronparse.FieldYear,
+			token:    "",
+			hint:     "",
+			expected: `column 13 (Year): unexpected trailing whitespace`,
+		},
+		{
+			exp:      "  0 10 * * ? *",
+			offset:   0,
+			field:    cronparse.FieldMinutes,
+			token:    "",
+			hint:     "an expression has 6 fields: minutes hours day-of-month month day-of-week year",
+			expected: `column 1 (Minutes): unexpected leading whitespace; an expression has 6 fields: minutes hours day-of-month month day-of-week year`,
+		},
+		{
+			exp:      "0 25 * * ? *",
+			offset:   2,
+			field:    cronparse.FieldHours,
+			token:    "25",
+			hint:     "hours must be 0-23",
+			expected: `column 3 (Hours): "25" is out of range (0-23); hours must be 0-23`,
+		},
+		{
+			exp:      "0 1,2,25 * * ? *",
+			offset:   6,
+			field:    cronparse.FieldHours,
+			token:    "25",
+			hint:     "hours must be 0-23",
+			expected: `column 7 (Hours): "25" is out of range (0-23); hours must be 0-23`,
+		},
+		{
+			exp:      "0 10 * * MON *",
+			offset:   5,
+			field:    cronparse.FieldDayOfMonth,
+			token:    "*",
+			hint:     "use ? in one of the day fields",
+			expected: `column 6 (DayOfMonth): exactly one of day-of-month and day-of-week must be '?'; use ? in one of the day fields`,
+		},
+	}
+
+	for _, t := range tt {
+		_, err := cronparse.Parse(t.exp)
+		var perr *cronparse.ParseError
+
+		if assert.True(errors.As(err, &perr), t.exp) {
+			assert.Equal(t.offset, perr.Offset, t.exp)
+			assert.Equal(t.field, perr.Field, t.exp)
+			assert.Equal(t.token, perr.Token, t.exp)
+			assert.Equal(t.hint, perr.Hint, t.exp)
+			assert.Equal(t.expected, perr.Error(), t.exp)
+		}
+	}
+}
+
+func TestParseErrorIndex(t *testing.T) {
+	assert := assert.New(t)
+
+	tt := []struct {
+		exp   string
+		index int
+		field cronparse.Field
+	}{
+		{"60 10 * * ? *", 0, cronparse.FieldMinutes},
+		{"0 25 * * ? *", 1, cronparse.FieldHours},
+		{"0 10 * * MON *", 2, cronparse.FieldDayOfMonth},
+		{"0 10 * FOO ? *", 3, cronparse.FieldMonth},
+		{"0 10 ? * X *", 4, cronparse.FieldDayOfWeek},
+		{"0 10 * * ? 1969", 5, cronparse.FieldYear},
+		{"0 10 * *", 4, cronparse.FieldDayOfWeek},
+		{"0 10 * * ? * *", 6, cronparse.Field(-1)},
+		{" 0 10 * * ? *", 0, cronparse.FieldMinutes},
+		{"0 10 * * ? *  ", 5, cronparse.FieldYear},
+	}
+
+	for _, t := range tt {
+		_, err := cronparse.Parse(t.exp)
+		var perr *cronparse.ParseError
+
+		if assert.True(errors.As(err, &perr), t.exp) {
+			assert.Equal(t.index, perr.Index, t.exp)
+			assert.Equal(t.field, perr.Field, t.exp)
+		}
+	}
+}
+
+func TestParseErrorUnwrap(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := cronparse.Parse("0 10 * * MON *")
+	assert.ErrorIs(err, cronparse.ErrDayFieldConflict)
+
+	_, err = cronparse.Parse("60 10 * * ? *")
+	var verr *cronparse.ValidationError
+	assert.True(errors.As(err, &verr))
+	assert.Equal(cronparse.FieldMinutes, verr.Field)
+}
@@ -264,12 +264,13 @@ func TestIntegrationMatch(t *testing.T) {
 				tm       time.Time
 				expected bool
 			}{
-				{time.Date(2022, 9, 17, 10, 15, 0, 0, time.UTC), true},
-				{time.Date(2022, 9, 24, 10, 15, 0, 0, time.UTC), false},
-				{time.Date(2022, 10, 15, 10, 15, 0, 0, time.UTC), true},
-				{time.Date(2022, 10, 8, 10, 15, 0, 0, time.UTC), false},
-				{time.Date(2022, 11, 19, 10, 15, 0, 0, time.UTC), true},
-				{time.Date(2022, 11, 16, 10, 15, 0, 0, time.UTC), false},
+				{time.Date(2022, 9, 16, 10, 15, 0, 0, time.UTC), true},
+				{time.Date(2022, 9, 17, 10, 15, 0, 0, time.UTC), false},
+				{time.Date(2022, 9, 23, 10, 15, 0, 0, time.UTC), false},
+				{time.Date(2022, 10, 21, 10, 15, 0, 0, time.UTC), true},
+				{time.Date(2022, 10, 14, 10, 15, 0, 0, time.UTC), false},
+				{time.Date(2022, 11, 18, 10, 15, 0, 0, time.UTC), true},
+				{time.Date(2022, 11, 11, 10, 15, 0, 0, time.UTC), false},
 			},
 		},
 		{
@@ -0,0 +1,54 @@
+//go:build go1.23
+
+package cronparse_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/winebarrel/cronparse"
+)
+
+func TestSeq(t *testing.T) {
+	assert := assert.New(t)
+	cron, err := cronparse.Parser.ParseString("", "0 10 ? * MON-FRI *")
+	assert.NoError(err)
+	schedule := []time.Time{}
+
+	for next := range cron.Seq(time.Date(2022, 10, 7, 11, 0, 0, 0, time.UTC)) {
+		if next.Month() != time.October || next.Day() > 12 {
+			break
+		}
+
+		schedule = append(schedule, next)
+	}
+
+	assert.Equal(
+		[]time.Time{
+			time.Date(2022, 10, 10, 10, 0, 0, 0, time.UTC),
+			time.Date(2022, 10, 11, 10, 0, 0, 0, time.UTC),
+			time.Date(2022, 10, 12, 10, 0, 0, 0, time.UTC),
+		},
+		schedule,
+	)
+}
+
+func TestSeqExhausted(t *testing.T) {
+	assert := assert.New(t)
+	cron, err := cronparse.Parser.ParseString("", "35 9 17 DEC ? 2022,2023")
+	assert.NoError(err)
+	schedule := []time.Time{}
+
+	for next := range cron.Seq(time.Date(2022, 10, 10, 0, 0, 0, 0, time.UTC)) {
+		schedule = append(schedule, next)
+	}
+
+	assert.Equal(
+		[]time.Time{
+			time.Date(2022, 12, 17, 9, 35, 0, 0, time.UTC),
+			time.Date(2023, 12, 17, 9, 35, 0, 0, time.UTC),
+		},
+		schedule,
+	)
+}
@@ -0,0 +1,130 @@
+package cronparse_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/winebarrel/cronparse"
+)
+
+func TestIter(t *testing.T) {
+	assert := assert.New(t)
+	cron, err := cronparse.Parser.ParseString("", "0 10 L * ? *")
+	assert.NoError(err)
+	it := cron.Iter(time.Date(2023, 12, 31, 11, 0, 0, 0, time.UTC))
+
+	expected := []time.Time{
+		time.Date(2024, 1, 31, 10, 0, 0, 0, time.UTC),
+		time.Date(2024, 2, 29, 10, 0, 0, 0, time.UTC),
+		time.Date(2024, 3, 31, 10, 0, 0, 0, time.UTC),
+		time.Date(2024, 4, 30, 10, 0, 0, 0, time.UTC),
+	}
+
+	for _, e := range expected {
+		next, ok := it.Next()
+		assert.True(ok)
+		assert.Equal(e, next)
+	}
+}
+
+func TestIterInEastOfUTC(t *testing.T) {
+	assert := assert.New(t)
+
+	berlin := loadLocation(t, "Europe/Berlin")
+	sydney := loadLocation(t, "Australia/Sydney")
+
+	// cronplan -source-tz walks cron() expressions with IterIn
+	tt := []struct {
+		loc      *time.Location
+		exp      string
+		from     time.Time
+		expected []time.Time
+	}{
+		{
+			loc:  berlin,
+			exp:  "cron(30 2 * * ? *)",
+			from: time.Date(2024, 10, 26, 12, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2024, 10, 27, 0, 30, 0, 0, time.UTC),
+				time.Date(2024, 10, 28, 1, 30, 0, 0, time.UTC),
+			},
+		},
+		{
+			loc:  sydney,
+			exp:  "cron(30 2 * * ? *)",
+			from: time.Date(2024, 4, 6, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2024, 4, 6, 15, 30, 0, 0, time.UTC),
+				time.Date(2024, 4, 7, 16, 30, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	for _, t := range tt {
+		s, err := cronparse.ParseScheduleExpression(t.exp)
+		assert.NoError(err)
+		it := s.(*cronparse.CronExpression).IterIn(t.from, t.loc)
+
+		for _, e := range t.expected {
+			next, ok := it.Next()
+			assert.True(ok, t.loc)
+			assert.True(e.Equal(next), t.loc, next)
+		}
+	}
+}
+
+func TestIterMatchesNextN(t *testing.T) {
+	assert := assert.New(t)
+	from := time.Date(2022, 10, 10, 5, 32, 0, 0, time.UTC)
+
+	tt := []string{
+		"*/5 * * * ? *",
+		"31 5 * * ? *",
+		"32 6 13,16 * ? *",
+		"33 7 ? * FRI *",
+		"0/10 22-23 ? * MON-FRI *",
+		"0 12 1/5 * ? 2022-2024",
+	}
+
+	for _, exp := range tt {
+		cron, err := cronparse.Parser.ParseString("", exp)
+		assert.NoError(err)
+		it := cron.Iter(from)
+
+		for _, e := range cron.NextN(from, 100) {
+			next, ok := it.Next()
+			assert.True(ok, exp)
+			assert.Equal(e, next, exp)
+		}
+	}
+}
+
+func TestIterExhausted(t *testing.T) {
+	assert := assert.New(t)
+	cron, err := cronparse.Parser.ParseString("", "0,30 9 17 DEC ? 2023")
+	assert.NoError(err)
+	it := cron.Iter(time.Date(2022, 10, 10, 0, 0, 0, 0, time.UTC))
+
+	next, ok := it.Next()
+	assert.True(ok)
+	assert.Equal(time.Date(2023, 12, 17, 9, 0, 0, 0, time.UTC), next)
+
+	next, ok = it.Next()
+	assert.True(ok)
+	assert.Equal(time.Date(2023, 12, 17, 9, 30, 0, 0, time.UTC), next)
+
+	for i := 0; i < 2; i++ {
+		next, ok = it.Next()
+		assert.False(ok)
+		assert.Equal(time.Time{}, next)
+	}
+}
+
+func TestIterDayFieldConflict(t *testing.T) {
+	assert := assert.New(t)
+	cron, err := cronparse.Parser.ParseString("", "0 10 * * * *")
+	assert.NoError(err)
+	_, ok := cron.Iter(time.Date(2022, 10, 10, 0, 0, 0, 0, time.UTC)).Next()
+	assert.False(ok)
+}
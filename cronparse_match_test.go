@@ -435,7 +435,7 @@ func TestMatchDayOfWeek(t *testing.T) {
 		}
 	}{
 		{
-			exp: "* * ? * 2 *",
+			exp: "* * ? * 3 *",
 			tests: []struct {
 				tm       time.Time
 				expected bool
@@ -446,7 +446,7 @@ func TestMatchDayOfWeek(t *testing.T) {
 			},
 		},
 		{
-			exp: "* * ? * 2,3 *",
+			exp: "* * ? * 3,4 *",
 			tests: []struct {
 				tm       time.Time
 				expected bool
@@ -458,7 +458,7 @@ func TestMatchDayOfWeek(t *testing.T) {
 			},
 		},
 		{
-			exp: "* * ? * 2-4 *",
+			exp: "* * ? * 3-5 *",
 			tests: []struct {
 				tm       time.Time
 				expected bool
@@ -42,6 +42,13 @@ func TestMinutesIncrementWildcard(t *testing.T) {
 	assert.Equal(&cronparse.Increment{Wildcard: true, Buttom: 5}, cron.Minutes.Exps[0].Increment)
 }
 
+func TestMinutesNumberRangeIncrement(t *testing.T) {
+	assert := assert.New(t)
+	cron, err := cronparse.Parser.ParseString("", "10-40/5 * * * ? *")
+	assert.NoError(err)
+	assert.Equal(&cronparse.NumberRangeIncrement{From: 10, To: 40, Buttom: 5}, cron.Minutes.Exps[0].NumberRangeIncrement)
+}
+
 func TestMinutesComplex(t *testing.T) {
 	assert := assert.New(t)
 	cron, err := cronparse.Parser.ParseString("", "*,0,0-59,0/5,*/5 * * * ? *")
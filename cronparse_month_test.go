@@ -66,9 +66,16 @@ func TestMonthNameRange(t *testing.T) {
 	assert.Equal(&cronparse.MonthRange{From: "JAN", To: "DEC"}, cron.Month.Exps[0].NameRange)
 }
 
+func TestMonthNameRangeIncrement(t *testing.T) {
+	assert := assert.New(t)
+	cron, err := cronparse.Parser.ParseString("", "* * * JAN-DEC/3 ? *")
+	assert.NoError(err)
+	assert.Equal(&cronparse.MonthRangeIncrement{From: "JAN", To: "DEC", Buttom: 3}, cron.Month.Exps[0].NameRangeIncrement)
+}
+
 func TestMonthComplex(t *testing.T) {
 	assert := assert.New(t)
-	cron, err := cronparse.Parser.ParseString("", "* * * *,1,1-12,1/5,*/5,JAN,JAN-DEC ? *")
+	cron, err := cronparse.Parser.ParseString("", "* * * *,1,1-12,1/5,*/5,JAN,JAN-DEC,2-11/3,FEB-NOV/3 ? *")
 	assert.NoError(err)
 	assert.Equal(&cronparse.All{}, cron.Month.Exps[0].All)
 	assert.Equal(&cronparse.Number{Value: 1}, cron.Month.Exps[1].Number)
@@ -77,4 +84,6 @@ func TestMonthComplex(t *testing.T) {
 	assert.Equal(&cronparse.Increment{Wildcard: true, Buttom: 5}, cron.Month.Exps[4].Increment)
 	assert.Equal(&cronparse.MonthName{Value: "JAN"}, cron.Month.Exps[5].Name)
 	assert.Equal(&cronparse.MonthRange{From: "JAN", To: "DEC"}, cron.Month.Exps[6].NameRange)
+	assert.Equal(&cronparse.NumberRangeIncrement{From: 2, To: 11, Buttom: 3}, cron.Month.Exps[7].NumberRangeIncrement)
+	assert.Equal(&cronparse.MonthRangeIncrement{From: "FEB", To: "NOV", Buttom: 3}, cron.Month.Exps[8].NameRangeIncrement)
 }
@@ -0,0 +1,198 @@
+package cronparse_test
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/winebarrel/cronparse"
+)
+
+func loadLocation(t *testing.T, name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return loc
+}
+
+func TestNextNIn(t *testing.T) {
+	assert := assert.New(t)
+	newYork := loadLocation(t, "America/New_York")
+	santiago := loadLocation(t, "America/Santiago")
+	kolkata := loadLocation(t, "Asia/Kolkata")
+	berlin := loadLocation(t, "Europe/Berlin")
+	sydney := loadLocation(t, "Australia/Sydney")
+	edt := time.FixedZone("EDT", -4*60*60)
+	est := time.FixedZone("EST", -5*60*60)
+	clt := time.FixedZone("-04", -4*60*60)
+	clst := time.FixedZone("-03", -3*60*60)
+	cest := time.FixedZone("CEST", 2*60*60)
+	cet := time.FixedZone("CET", 1*60*60)
+	aedt := time.FixedZone("AEDT", 11*60*60)
+	aest := time.FixedZone("AEST", 10*60*60)
+
+	tt := []struct {
+		name     string
+		exp      string
+		loc      *time.Location
+		from     time.Time
+		expected []time.Time
+	}{
+		{
+			name: "skipped hour",
+			exp:  "30 2 * * ? *",
+			loc:  newYork,
+			from: time.Date(2022, 3, 12, 0, 0, 0, 0, est),
+			expected: []time.Time{
+				time.Date(2022, 3, 12, 2, 30, 0, 0, est),
+				time.Date(2022, 3, 14, 2, 30, 0, 0, edt),
+				time.Date(2022, 3, 15, 2, 30, 0, 0, edt),
+			},
+		},
+		{
+			name: "every 30 minutes across the skipped hour",
+			exp:  "*/30 * * * ? *",
+			loc:  newYork,
+			from: time.Date(2022, 3, 13, 1, 0, 0, 0, est),
+			expected: []time.Time{
+				time.Date(2022, 3, 13, 1, 0, 0, 0, est),
+				time.Date(2022, 3, 13, 1, 30, 0, 0, est),
+				time.Date(2022, 3, 13, 3, 0, 0, 0, edt),
+				time.Date(2022, 3, 13, 3, 30, 0, 0, edt),
+			},
+		},
+		{
+			name: "repeated hour",
+			exp:  "30 1 * * ? *",
+			loc:  newYork,
+			from: time.Date(2022, 11, 5, 0, 0, 0, 0, edt),
+			expected: []time.Time{
+				time.Date(2022, 11, 5, 1, 30, 0, 0, edt),
+				time.Date(2022, 11, 6, 1, 30, 0, 0, edt),
+				time.Date(2022, 11, 7, 1, 30, 0, 0, est),
+			},
+		},
+		{
+			name: "every 30 minutes across the repeated hour",
+			exp:  "*/30 * * * ? *",
+			loc:  newYork,
+			from: time.Date(2022, 11, 6, 0, 0, 0, 0, edt),
+			expected: []time.Time{
+				time.Date(2022, 11, 6, 0, 0, 0, 0, edt),
+				time.Date(2022, 11, 6, 0, 30, 0, 0, edt),
+				time.Date(2022, 11, 6, 1, 0, 0, 0, edt),
+				time.Date(2022, 11, 6, 1, 30, 0, 0, edt),
+				time.Date(2022, 11, 6, 2, 0, 0, 0, est),
+				time.Date(2022, 11, 6, 2, 30, 0, 0, est),
+			},
+		},
+		{
+			name: "from the second pass of the repeated hour",
+			exp:  "*/30 * * * ? *",
+			loc:  newYork,
+			from: time.Date(2022, 11, 6, 1, 10, 0, 0, est),
+			expected: []time.Time{
+				time.Date(2022, 11, 6, 2, 0, 0, 0, est),
+				time.Date(2022, 11, 6, 2, 30, 0, 0, est),
+			},
+		},
+		{
+			name: "repeated hour east of UTC",
+			exp:  "30 2 * * ? *",
+			loc:  berlin,
+			from: time.Date(2024, 10, 26, 0, 0, 0, 0, cest),
+			expected: []time.Time{
+				time.Date(2024, 10, 26, 2, 30, 0, 0, cest),
+				time.Date(2024, 10, 27, 2, 30, 0, 0, cest),
+				time.Date(2024, 10, 28, 2, 30, 0, 0, cet),
+			},
+		},
+		{
+			name: "every 30 minutes across the repeated hour east of UTC",
+			exp:  "*/30 2 * * ? *",
+			loc:  berlin,
+			from: time.Date(2024, 10, 27, 0, 0, 0, 0, cest),
+			expected: []time.Time{
+				time.Date(2024, 10, 27, 2, 0, 0, 0, cest),
+				time.Date(2024, 10, 27, 2, 30, 0, 0, cest),
+				time.Date(2024, 10, 28, 2, 0, 0, 0, cet),
+			},
+		},
+		{
+			name: "from the second pass of the repeated hour east of UTC",
+			exp:  "*/30 2 * * ? *",
+			loc:  berlin,
+			from: time.Date(2024, 10, 27, 2, 10, 0, 0, cet),
+			expected: []time.Time{
+				time.Date(2024, 10, 28, 2, 0, 0, 0, cet),
+			},
+		},
+		{
+			name: "repeated hour in the southern hemisphere",
+			exp:  "30 2 * * ? *",
+			loc:  sydney,
+			from: time.Date(2024, 4, 6, 0, 0, 0, 0, aedt),
+			expected: []time.Time{
+				time.Date(2024, 4, 6, 2, 30, 0, 0, aedt),
+				time.Date(2024, 4, 7, 2, 30, 0, 0, aedt),
+				time.Date(2024, 4, 8, 2, 30, 0, 0, aest),
+			},
+		},
+		{
+			name: "skipped midnight",
+			exp:  "0 0 * * ? *",
+			loc:  santiago,
+			from: time.Date(2022, 9, 10, 0, 0, 0, 0, clt),
+			expected: []time.Time{
+				time.Date(2022, 9, 10, 0, 0, 0, 0, clt),
+				time.Date(2022, 9, 12, 0, 0, 0, 0, clst),
+				time.Date(2022, 9, 13, 0, 0, 0, 0, clst),
+			},
+		},
+		{
+			name: "day with skipped midnight",
+			exp:  "0 12 11 SEP ? *",
+			loc:  santiago,
+			from: time.Date(2022, 9, 1, 0, 0, 0, 0, clt),
+			expected: []time.Time{
+				time.Date(2022, 9, 11, 12, 0, 0, 0, clst),
+			},
+		},
+		{
+			name: "half-hour zone",
+			exp:  "0 10 * * ? *",
+			loc:  kolkata,
+			from: time.Date(2022, 10, 10, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2022, 10, 10, 4, 30, 0, 0, time.UTC),
+				time.Date(2022, 10, 11, 4, 30, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	for _, t := range tt {
+		cron, err := cronparse.Parse(t.exp)
+		assert.NoError(err)
+		schedule := cron.NextNIn(t.from, t.loc, len(t.expected))
+
+		if assert.Equal(len(t.expected), len(schedule), t.name) {
+			for i := range t.expected {
+				assert.True(t.expected[i].Equal(schedule[i]), t.name, schedule[i])
+				assert.Equal(t.loc, schedule[i].Location(), t.name)
+			}
+		}
+
+		assert.True(t.expected[0].Equal(cron.NextIn(t.from, t.loc)), t.name)
+	}
+}
+
+func TestNextInLimit(t *testing.T) {
+	assert := assert.New(t)
+	cron, err := cronparse.Parse("35 9 17 DEC ? 2020")
+	assert.NoError(err)
+	assert.Equal(time.Time{}, cron.NextIn(time.Date(2022, 10, 10, 0, 0, 0, 0, time.UTC), time.UTC))
+}
@@ -227,3 +227,368 @@ func TestNextN_3(t *testing.T) {
 		assert.Equal(t.expected, next, t)
 	}
 }
+
+func TestNextNWrapAround(t *testing.T) {
+	assert := assert.New(t)
+
+	tt := []struct {
+		exp      string
+		from     time.Time
+		expected []time.Time
+	}{
+		{
+			exp:  "0/30 22-2 ? * FRI-MON *",
+			from: time.Date(2022, 10, 10, 1, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2022, 10, 10, 1, 0, 0, 0, time.UTC),
+				time.Date(2022, 10, 10, 1, 30, 0, 0, time.UTC),
+				time.Date(2022, 10, 10, 2, 0, 0, 0, time.UTC),
+				time.Date(2022, 10, 10, 2, 30, 0, 0, time.UTC),
+				time.Date(2022, 10, 10, 22, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			exp:  "0 0 1 NOV-FEB ? *",
+			from: time.Date(2022, 10, 10, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2022, 11, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2022, 12, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2023, 11, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			exp:  "0 12 28-2 * ? *",
+			from: time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2023, 2, 1, 12, 0, 0, 0, time.UTC),
+				time.Date(2023, 2, 2, 12, 0, 0, 0, time.UTC),
+				time.Date(2023, 2, 28, 12, 0, 0, 0, time.UTC),
+				time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC),
+				time.Date(2023, 3, 2, 12, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			exp:  "0 9 ? * 6-2 *",
+			from: time.Date(2022, 10, 11, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2022, 10, 14, 9, 0, 0, 0, time.UTC),
+				time.Date(2022, 10, 15, 9, 0, 0, 0, time.UTC),
+				time.Date(2022, 10, 16, 9, 0, 0, 0, time.UTC),
+				time.Date(2022, 10, 17, 9, 0, 0, 0, time.UTC),
+				time.Date(2022, 10, 21, 9, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	for _, t := range tt {
+		cron, err := cronparse.Parse(t.exp)
+		assert.NoError(err)
+		assert.Equal(t.exp, cron.String())
+		assert.Equal(t.expected, cron.NextN(t.from, len(t.expected)), t.exp)
+
+		schedule, err := cron.Compile()
+		assert.NoError(err)
+		assert.Equal(t.expected, schedule.NextN(t.from, len(t.expected)), t.exp)
+
+		last := t.expected[len(t.expected)-1]
+		assert.Equal(t.expected[len(t.expected)-2], cron.Prev(last.Add(-time.Minute)), t.exp)
+	}
+}
+
+func TestNextNRangeIncrement(t *testing.T) {
+	assert := assert.New(t)
+
+	tt := []struct {
+		exp      string
+		from     time.Time
+		expected []time.Time
+	}{
+		{
+			exp:  "0-30/10 9 * * ? *",
+			from: time.Date(2022, 10, 10, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2022, 10, 10, 9, 0, 0, 0, time.UTC),
+				time.Date(2022, 10, 10, 9, 10, 0, 0, time.UTC),
+				time.Date(2022, 10, 10, 9, 20, 0, 0, time.UTC),
+				time.Date(2022, 10, 10, 9, 30, 0, 0, time.UTC),
+				time.Date(2022, 10, 11, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			exp:  "0 9 ? * MON-FRI/2 *",
+			from: time.Date(2022, 10, 10, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2022, 10, 10, 9, 0, 0, 0, time.UTC),
+				time.Date(2022, 10, 12, 9, 0, 0, 0, time.UTC),
+				time.Date(2022, 10, 14, 9, 0, 0, 0, time.UTC),
+				time.Date(2022, 10, 17, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			exp:  "0 0 1 JAN-DEC/3 ? *",
+			from: time.Date(2022, 10, 10, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			exp:  "0 22-2/2 * * ? *",
+			from: time.Date(2022, 10, 10, 0, 30, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2022, 10, 10, 2, 0, 0, 0, time.UTC),
+				time.Date(2022, 10, 10, 22, 0, 0, 0, time.UTC),
+				time.Date(2022, 10, 11, 0, 0, 0, 0, time.UTC),
+				time.Date(2022, 10, 11, 2, 0, 0, 0, time.UTC),
+				time.Date(2022, 10, 11, 22, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			exp:  "0 9 ? * MON-SUN/2 *",
+			from: time.Date(2022, 10, 8, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2022, 10, 9, 9, 0, 0, 0, time.UTC),
+				time.Date(2022, 10, 10, 9, 0, 0, 0, time.UTC),
+				time.Date(2022, 10, 12, 9, 0, 0, 0, time.UTC),
+				time.Date(2022, 10, 14, 9, 0, 0, 0, time.UTC),
+				time.Date(2022, 10, 16, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			exp:  "0 0 1 NOV-FEB/2 ? *",
+			from: time.Date(2022, 10, 10, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2022, 11, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2023, 11, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	for _, t := range tt {
+		cron, err := cronparse.Parse(t.exp)
+		assert.NoError(err)
+		assert.Equal(t.exp, cron.String())
+		assert.Equal(t.expected, cron.NextN(t.from, len(t.expected)), t.exp)
+
+		schedule, err := cron.Compile()
+		assert.NoError(err)
+		assert.Equal(t.expected, schedule.NextN(t.from, len(t.expected)), t.exp)
+	}
+}
+
+func TestNextNLastOfMonth(t *testing.T) {
+	assert := assert.New(t)
+
+	tt := []struct {
+		exp      string
+		from     time.Time
+		expected []time.Time
+	}{
+		{
+			exp:  "0 0 LW * ? *",
+			from: time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2023, 8, 31, 0, 0, 0, 0, time.UTC),
+				time.Date(2023, 9, 29, 0, 0, 0, 0, time.UTC),
+				time.Date(2023, 10, 31, 0, 0, 0, 0, time.UTC),
+				time.Date(2023, 11, 30, 0, 0, 0, 0, time.UTC),
+				time.Date(2023, 12, 29, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			exp:  "0 0 LW FEB ? *",
+			from: time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2019, 2, 28, 0, 0, 0, 0, time.UTC),
+				time.Date(2020, 2, 28, 0, 0, 0, 0, time.UTC),
+				time.Date(2021, 2, 26, 0, 0, 0, 0, time.UTC),
+				time.Date(2022, 2, 28, 0, 0, 0, 0, time.UTC),
+				time.Date(2023, 2, 28, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			exp:  "0 0 L-3 * ? *",
+			from: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2024, 1, 28, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, 2, 26, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, 3, 28, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, 4, 27, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			exp:  "0 0 L-1 FEB ? *",
+			from: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2023, 2, 27, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, 2, 28, 0, 0, 0, 0, time.UTC),
+				time.Date(2025, 2, 27, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			exp:  "0 0 L-30 * ? *",
+			from: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	for _, t := range tt {
+		cron, err := cronparse.Parse(t.exp)
+		assert.NoError(err)
+		assert.Equal(t.exp, cron.String())
+		assert.Equal(t.expected, cron.NextN(t.from, len(t.expected)), t.exp)
+
+		schedule, err := cron.Compile()
+		assert.NoError(err)
+		assert.Equal(t.expected, schedule.NextN(t.from, len(t.expected)), t.exp)
+
+		last := t.expected[len(t.expected)-1]
+		assert.Equal(t.expected[len(t.expected)-2], cron.Prev(last.Add(-time.Minute)), t.exp)
+	}
+}
+
+func TestNextNLastInstance(t *testing.T) {
+	assert := assert.New(t)
+
+	tt := []struct {
+		exp      string
+		from     time.Time
+		expected []time.Time
+	}{
+		{
+			exp:  "0 18 ? * 6L *",
+			from: time.Date(2023, 9, 1, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2023, 9, 29, 18, 0, 0, 0, time.UTC),
+				time.Date(2023, 10, 27, 18, 0, 0, 0, time.UTC),
+				time.Date(2023, 11, 24, 18, 0, 0, 0, time.UTC),
+				time.Date(2023, 12, 29, 18, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			exp:  "0 18 ? * FRIL *",
+			from: time.Date(2023, 9, 1, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2023, 9, 29, 18, 0, 0, 0, time.UTC),
+				time.Date(2023, 10, 27, 18, 0, 0, 0, time.UTC),
+				time.Date(2023, 11, 24, 18, 0, 0, 0, time.UTC),
+				time.Date(2023, 12, 29, 18, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			exp:  "0 0 ? FEB 5L *",
+			from: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2023, 2, 23, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+				time.Date(2025, 2, 27, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			exp:  "0 0 ? * 2#1,2L *",
+			from: time.Date(2023, 9, 1, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2023, 9, 4, 0, 0, 0, 0, time.UTC),
+				time.Date(2023, 9, 25, 0, 0, 0, 0, time.UTC),
+				time.Date(2023, 10, 2, 0, 0, 0, 0, time.UTC),
+				time.Date(2023, 10, 30, 0, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	for _, t := range tt {
+		cron, err := cronparse.Parse(t.exp)
+		assert.NoError(err)
+		assert.Equal(t.exp, cron.String())
+		assert.Equal(t.expected, cron.NextN(t.from, len(t.expected)), t.exp)
+
+		schedule, err := cron.Compile()
+		assert.NoError(err)
+		assert.Equal(t.expected, schedule.NextN(t.from, len(t.expected)), t.exp)
+
+		last := t.expected[len(t.expected)-1]
+		assert.Equal(t.expected[len(t.expected)-2], cron.Prev(last.Add(-time.Minute)), t.exp)
+	}
+}
+
+func TestNextNWeekdayMonthBoundary(t *testing.T) {
+	assert := assert.New(t)
+
+	tt := []struct {
+		exp      string
+		from     time.Time
+		expected []time.Time
+	}{
+		{
+			exp:  "0 0 1W * ? *",
+			from: time.Date(2022, 9, 1, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2022, 9, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2022, 10, 3, 0, 0, 0, 0, time.UTC),
+				time.Date(2022, 11, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2022, 12, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			exp:  "0 0 31W * ? *",
+			from: time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2023, 10, 31, 0, 0, 0, 0, time.UTC),
+				time.Date(2023, 12, 29, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, 3, 29, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			exp:  "0 0 29W FEB ? *",
+			from: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+				time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	for _, t := range tt {
+		cron, err := cronparse.Parse(t.exp)
+		assert.NoError(err)
+		assert.Equal(t.expected, cron.NextN(t.from, len(t.expected)), t.exp)
+
+		schedule, err := cron.Compile()
+		assert.NoError(err)
+		assert.Equal(t.expected, schedule.NextN(t.from, len(t.expected)), t.exp)
+
+		last := t.expected[len(t.expected)-1]
+		assert.Equal(t.expected[len(t.expected)-2], cron.Prev(last.Add(-time.Minute)), t.exp)
+	}
+}
+
+func TestNextNFifthInstance(t *testing.T) {
+	assert := assert.New(t)
+	cron, err := cronparse.Parse("0 0 ? * 2#5 *")
+	assert.NoError(err)
+	from := time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)
+	// Only May, July and October 2023 have five Mondays
+	expected := []time.Time{
+		time.Date(2023, 5, 29, 0, 0, 0, 0, time.UTC),
+		time.Date(2023, 7, 31, 0, 0, 0, 0, time.UTC),
+		time.Date(2023, 10, 30, 0, 0, 0, 0, time.UTC),
+	}
+
+	assert.Equal(expected, cron.NextN(from, 3))
+	assert.Equal(expected[1], cron.Prev(expected[2].Add(-time.Minute)))
+	assert.Equal(3, cron.Count(from, expected[2].Add(time.Minute)))
+
+	schedule, err := cron.Compile()
+	assert.NoError(err)
+	assert.Equal(expected, schedule.NextN(from, 3))
+}
@@ -0,0 +1,276 @@
+package cronparse_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/winebarrel/cronparse"
+)
+
+func TestPrev(t *testing.T) {
+	assert := assert.New(t)
+
+	tt := []struct {
+		exp      string
+		from     time.Time
+		expected time.Time
+	}{
+		{
+			exp:      "30 * * * ? *",
+			from:     time.Date(2022, 10, 10, 0, 0, 0, 0, time.UTC),
+			expected: time.Date(2022, 10, 9, 23, 30, 0, 0, time.UTC),
+		},
+		{
+			exp:      "31 * * * ? *",
+			from:     time.Date(2022, 10, 10, 0, 32, 0, 0, time.UTC),
+			expected: time.Date(2022, 10, 10, 0, 31, 0, 0, time.UTC),
+		},
+		{
+			exp:      "31 * * * ? *",
+			from:     time.Date(2022, 10, 10, 0, 31, 0, 0, time.UTC),
+			expected: time.Date(2022, 10, 10, 0, 31, 0, 0, time.UTC),
+		},
+		{
+			exp:      "31 5 * * ? *",
+			from:     time.Date(2022, 10, 10, 0, 0, 0, 0, time.UTC),
+			expected: time.Date(2022, 10, 9, 5, 31, 0, 0, time.UTC),
+		},
+		{
+			exp:      "31 5 * * ? *",
+			from:     time.Date(2022, 10, 10, 5, 32, 0, 0, time.UTC),
+			expected: time.Date(2022, 10, 10, 5, 31, 0, 0, time.UTC),
+		},
+		{
+			exp:      "32 6 13,16 * ? *",
+			from:     time.Date(2022, 10, 10, 0, 0, 0, 0, time.UTC),
+			expected: time.Date(2022, 9, 16, 6, 32, 0, 0, time.UTC),
+		},
+		{
+			exp:      "32 6 13,16 * ? *",
+			from:     time.Date(2022, 10, 16, 6, 31, 0, 0, time.UTC),
+			expected: time.Date(2022, 10, 13, 6, 32, 0, 0, time.UTC),
+		},
+		{
+			exp:      "33 7 ? * FRI *",
+			from:     time.Date(2022, 10, 10, 0, 0, 0, 0, time.UTC),
+			expected: time.Date(2022, 10, 7, 7, 33, 0, 0, time.UTC),
+		},
+		{
+			exp:      "33 7 ? * FRI *",
+			from:     time.Date(2022, 10, 14, 7, 33, 0, 0, time.UTC),
+			expected: time.Date(2022, 10, 14, 7, 33, 0, 0, time.UTC),
+		},
+		{
+			exp:      "34 8 15 NOV ? *",
+			from:     time.Date(2022, 10, 10, 0, 0, 0, 0, time.UTC),
+			expected: time.Date(2021, 11, 15, 8, 34, 0, 0, time.UTC),
+		},
+		{
+			exp:      "34 8 15 SEP ? *",
+			from:     time.Date(2022, 10, 10, 0, 0, 0, 0, time.UTC),
+			expected: time.Date(2022, 9, 15, 8, 34, 0, 0, time.UTC),
+		},
+		{
+			exp:      "35 9 17 DEC ? 2021",
+			from:     time.Date(2022, 10, 10, 0, 0, 0, 0, time.UTC),
+			expected: time.Date(2021, 12, 17, 9, 35, 0, 0, time.UTC),
+		},
+		{
+			exp:      "35 9 17 DEC ? 2023",
+			from:     time.Date(2022, 10, 10, 0, 0, 0, 0, time.UTC),
+			expected: time.Time{},
+		},
+		{
+			exp:      "59 23 31 DEC ? *",
+			from:     time.Date(2022, 10, 10, 0, 0, 0, 0, time.UTC),
+			expected: time.Date(2021, 12, 31, 23, 59, 0, 0, time.UTC),
+		},
+		{
+			exp:      "0 0 1 JAN ? *",
+			from:     time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+			expected: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			exp:      "0 10 L * ? *",
+			from:     time.Date(2022, 10, 10, 0, 0, 0, 0, time.UTC),
+			expected: time.Date(2022, 9, 30, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			exp:      "0 10 L FEB ? *",
+			from:     time.Date(2022, 10, 10, 0, 0, 0, 0, time.UTC),
+			expected: time.Date(2022, 2, 28, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			exp:      "0 10 L FEB ? *",
+			from:     time.Date(2024, 10, 10, 0, 0, 0, 0, time.UTC),
+			expected: time.Date(2024, 2, 29, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			exp:      "0 10 3W * ? *",
+			from:     time.Date(2022, 10, 10, 0, 0, 0, 0, time.UTC),
+			expected: time.Date(2022, 10, 3, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			exp:      "0 10 3W * ? *",
+			from:     time.Date(2022, 10, 1, 0, 0, 0, 0, time.UTC),
+			expected: time.Date(2022, 9, 2, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			exp:      "15 10 ? * 6#3 *",
+			from:     time.Date(2022, 10, 10, 0, 0, 0, 0, time.UTC),
+			expected: time.Date(2022, 9, 16, 10, 15, 0, 0, time.UTC),
+		},
+		{
+			exp:      "15 10 ? * 6#3 *",
+			from:     time.Date(2022, 10, 21, 10, 15, 0, 0, time.UTC),
+			expected: time.Date(2022, 10, 21, 10, 15, 0, 0, time.UTC),
+		},
+		{
+			exp:      "0 12 ? * L *",
+			from:     time.Date(2022, 10, 10, 0, 0, 0, 0, time.UTC),
+			expected: time.Date(2022, 10, 8, 12, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, t := range tt {
+		cron, err := cronparse.Parser.ParseString("", t.exp)
+		assert.NoError(err)
+		prev := cron.Prev(t.from)
+		assert.Equal(t.expected, prev, t)
+	}
+}
+
+func TestPrevN_10(t *testing.T) {
+	assert := assert.New(t)
+	cron, err := cronparse.Parser.ParseString("", "*/5 * * * ? *")
+	assert.NoError(err)
+	schedule := cron.PrevN(time.Date(2022, 10, 10, 0, 0, 0, 0, time.UTC), 10)
+	assert.Equal(
+		[]time.Time{
+			time.Date(2022, time.October, 10, 0, 0, 0, 0, time.UTC),
+			time.Date(2022, time.October, 9, 23, 55, 0, 0, time.UTC),
+			time.Date(2022, time.October, 9, 23, 50, 0, 0, time.UTC),
+			time.Date(2022, time.October, 9, 23, 45, 0, 0, time.UTC),
+			time.Date(2022, time.October, 9, 23, 40, 0, 0, time.UTC),
+			time.Date(2022, time.October, 9, 23, 35, 0, 0, time.UTC),
+			time.Date(2022, time.October, 9, 23, 30, 0, 0, time.UTC),
+			time.Date(2022, time.October, 9, 23, 25, 0, 0, time.UTC),
+			time.Date(2022, time.October, 9, 23, 20, 0, 0, time.UTC),
+			time.Date(2022, time.October, 9, 23, 15, 0, 0, time.UTC),
+		},
+		schedule,
+	)
+}
+
+func TestPrevN_3(t *testing.T) {
+	assert := assert.New(t)
+
+	tt := []struct {
+		exp      string
+		from     time.Time
+		expected []time.Time
+	}{
+		{
+			exp:  "30 * * * ? *",
+			from: time.Date(2022, 10, 10, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2022, 10, 9, 23, 30, 0, 0, time.UTC),
+				time.Date(2022, 10, 9, 22, 30, 0, 0, time.UTC),
+				time.Date(2022, 10, 9, 21, 30, 0, 0, time.UTC),
+			},
+		},
+		{
+			exp:  "31 5 * * ? *",
+			from: time.Date(2022, 10, 10, 5, 32, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2022, 10, 10, 5, 31, 0, 0, time.UTC),
+				time.Date(2022, 10, 9, 5, 31, 0, 0, time.UTC),
+				time.Date(2022, 10, 8, 5, 31, 0, 0, time.UTC),
+			},
+		},
+		{
+			exp:  "32 6 13,16 * ? *",
+			from: time.Date(2022, 10, 10, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2022, 9, 16, 6, 32, 0, 0, time.UTC),
+				time.Date(2022, 9, 13, 6, 32, 0, 0, time.UTC),
+				time.Date(2022, 8, 16, 6, 32, 0, 0, time.UTC),
+			},
+		},
+		{
+			exp:  "33 7 ? * FRI *",
+			from: time.Date(2022, 10, 10, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2022, 10, 7, 7, 33, 0, 0, time.UTC),
+				time.Date(2022, 9, 30, 7, 33, 0, 0, time.UTC),
+				time.Date(2022, 9, 23, 7, 33, 0, 0, time.UTC),
+			},
+		},
+		{
+			exp:  "34 8 15 NOV ? *",
+			from: time.Date(2022, 10, 10, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2021, 11, 15, 8, 34, 0, 0, time.UTC),
+				time.Date(2020, 11, 15, 8, 34, 0, 0, time.UTC),
+				time.Date(2019, 11, 15, 8, 34, 0, 0, time.UTC),
+			},
+		},
+		{
+			exp:  "0,30 9 1 JAN,JUL ? *",
+			from: time.Date(2022, 10, 10, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2022, 7, 1, 9, 30, 0, 0, time.UTC),
+				time.Date(2022, 7, 1, 9, 0, 0, 0, time.UTC),
+				time.Date(2022, 1, 1, 9, 30, 0, 0, time.UTC),
+			},
+		},
+		{
+			exp:  "35 9 17 DEC ? 2020,2021",
+			from: time.Date(2022, 10, 10, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2021, 12, 17, 9, 35, 0, 0, time.UTC),
+				time.Date(2020, 12, 17, 9, 35, 0, 0, time.UTC),
+			},
+		},
+		{
+			exp:  "0 10 L * ? *",
+			from: time.Date(2024, 4, 10, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2024, 3, 31, 10, 0, 0, 0, time.UTC),
+				time.Date(2024, 2, 29, 10, 0, 0, 0, time.UTC),
+				time.Date(2024, 1, 31, 10, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			exp:  "0 10 3W * ? *",
+			from: time.Date(2022, 10, 10, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2022, 10, 3, 10, 0, 0, 0, time.UTC),
+				time.Date(2022, 9, 2, 10, 0, 0, 0, time.UTC),
+				time.Date(2022, 8, 3, 10, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			exp:  "15 10 ? * 6#3 *",
+			from: time.Date(2022, 10, 10, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2022, 9, 16, 10, 15, 0, 0, time.UTC),
+				time.Date(2022, 8, 19, 10, 15, 0, 0, time.UTC),
+				time.Date(2022, 7, 15, 10, 15, 0, 0, time.UTC),
+			},
+		},
+		{
+			exp:      "35 9 17 DEC ? 2023",
+			from:     time.Date(2022, 10, 10, 0, 0, 0, 0, time.UTC),
+			expected: []time.Time{},
+		},
+	}
+
+	for _, t := range tt {
+		cron, err := cronparse.Parser.ParseString("", t.exp)
+		assert.NoError(err)
+		prev := cron.PrevN(t.from, 3)
+		assert.Equal(t.expected, prev, t)
+	}
+}
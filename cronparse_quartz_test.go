@@ -0,0 +1,210 @@
+package cronparse_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/winebarrel/cronparse"
+)
+
+func TestParseQuartz(t *testing.T) {
+	assert := assert.New(t)
+
+	tt := []struct {
+		exp      string
+		expected string
+	}{
+		{"0 0 10 * * ?", "0 0 10 * * ?"},
+		{"0 0 10 * * ? 2024", "0 0 10 * * ? 2024"},
+		{"0/30 0/15 9-17 ? * MON-FRI", "0/30 0/15 9-17 ? * MON-FRI"},
+		{"*/5,59 * * L * ?", "*/5,59 * * L * ?"},
+		{"0 0 10 ? * 6#3 2024-2099", "0 0 10 ? * 6#3 2024-2099"},
+		{"0 0 10 15W * ?", "0 0 10 15W * ?"},
+		{"0 0 10 ? * L", "0 0 10 ? * L"},
+		{"30 0 12 ? * FRIL", "30 0 12 ? * FRIL"},
+		{"0 0 10 * * ? *", "0 0 10 * * ? *"},
+	}
+
+	for _, t := range tt {
+		cron, err := cronparse.ParseQuartz(t.exp)
+
+		if assert.NoError(err, t.exp) {
+			assert.Equal(t.expected, cron.String(), t.exp)
+			assert.NotNil(cron.Seconds, t.exp)
+		}
+	}
+}
+
+func TestParseQuartzError(t *testing.T) {
+	assert := assert.New(t)
+
+	tt := []struct {
+		exp      string
+		index    int
+		field    cronparse.Field
+		expected string
+	}{
+		{"60 0 10 * * ?", 0, cronparse.FieldSeconds, `column 1 (Seconds): "60" is out of range (0-59); seconds must be 0-59`},
+		{"0 60 10 * * ?", 1, cronparse.FieldMinutes, `column 3 (Minutes): "60" is out of range (0-59); minutes must be 0-59`},
+		{"0 0 10 * * ? 2100", 6, cronparse.FieldYear, `column 14 (Year): "2100" is out of range (1970-2099); year must be 1970-2099`},
+		{"0 0 10 * * *", 3, cronparse.FieldDayOfMonth, `column 8 (DayOfMonth): exactly one of day-of-month and day-of-week must be '?'; use ? in one of the day fields`},
+		{"0 0 10 * *", 5, cronparse.FieldDayOfWeek, `column 11 (DayOfWeek): unexpected end of expression; a Quartz expression has 6 or 7 fields: seconds minutes hours day-of-month month day-of-week [year]`},
+		{"0 0 10 * * ? * *", 7, cronparse.Field(-1), `column 16: unexpected token "*"; a Quartz expression has 6 or 7 fields: seconds minutes hours day-of-month month day-of-week [year]`},
+		{"x 0 10 * * ?", 0, cronparse.FieldSeconds, `column 1 (Seconds): unexpected token "x"; seconds must be 0-59`},
+		{" 0 0 10 * * ?", 0, cronparse.FieldSeconds, `column 1 (Seconds): unexpected leading whitespace; a Quartz expression has 6 or 7 fields: seconds minutes hours day-of-month month day-of-week [year]`},
+	}
+
+	for _, t := range tt {
+		_, err := cronparse.ParseQuartz(t.exp)
+		var perr *cronparse.ParseError
+
+		if assert.True(errors.As(err, &perr), t.exp) {
+			assert.Equal(t.index, perr.Index, t.exp)
+			assert.Equal(t.field, perr.Field, t.exp)
+			assert.Equal(t.expected, perr.Error(), t.exp)
+		}
+	}
+}
+
+func TestQuartzNext(t *testing.T) {
+	assert := assert.New(t)
+	cron, err := cronparse.ParseQuartz("*/15 * 10 * * ?")
+	assert.NoError(err)
+
+	assert.Equal(
+		[]time.Time{
+			time.Date(2022, 10, 10, 10, 0, 15, 0, time.UTC),
+			time.Date(2022, 10, 10, 10, 0, 30, 0, time.UTC),
+			time.Date(2022, 10, 10, 10, 0, 45, 0, time.UTC),
+			time.Date(2022, 10, 10, 10, 1, 0, 0, time.UTC),
+		},
+		cron.NextN(time.Date(2022, 10, 10, 10, 0, 7, 0, time.UTC), 4),
+	)
+
+	// from is inclusive
+	assert.Equal(time.Date(2022, 10, 10, 10, 0, 15, 0, time.UTC), cron.Next(time.Date(2022, 10, 10, 10, 0, 15, 0, time.UTC)))
+	// the last second of the hour carries to the next day
+	assert.Equal(time.Date(2022, 10, 11, 10, 0, 0, 0, time.UTC), cron.Next(time.Date(2022, 10, 10, 10, 59, 46, 0, time.UTC)))
+}
+
+func TestQuartzPrev(t *testing.T) {
+	assert := assert.New(t)
+	cron, err := cronparse.ParseQuartz("*/15 * * * * ?")
+	assert.NoError(err)
+
+	assert.Equal(
+		[]time.Time{
+			time.Date(2022, 10, 10, 10, 0, 15, 0, time.UTC),
+			time.Date(2022, 10, 10, 10, 0, 0, 0, time.UTC),
+			time.Date(2022, 10, 10, 9, 59, 45, 0, time.UTC),
+		},
+		cron.PrevN(time.Date(2022, 10, 10, 10, 0, 20, 0, time.UTC), 3),
+	)
+}
+
+func TestQuartzCount(t *testing.T) {
+	assert := assert.New(t)
+	cron, err := cronparse.ParseQuartz("*/15 * * * * ?")
+	assert.NoError(err)
+
+	from := time.Date(2022, 10, 10, 10, 0, 20, 0, time.UTC)
+	to := time.Date(2022, 10, 12, 10, 0, 0, 0, time.UTC)
+	assert.Equal(len(cron.Between(from, to)), cron.Count(from, to))
+	assert.Equal(2*24*60*4-2, cron.Count(from, to))
+}
+
+func TestQuartzMatch(t *testing.T) {
+	assert := assert.New(t)
+	cron, err := cronparse.ParseQuartz("30 0 10 * * ?")
+	assert.NoError(err)
+	schedule, err := cron.Compile()
+	assert.NoError(err)
+
+	for _, m := range []interface{ Match(time.Time) bool }{cron, schedule} {
+		assert.True(m.Match(time.Date(2022, 10, 10, 10, 0, 30, 0, time.UTC)))
+		assert.False(m.Match(time.Date(2022, 10, 10, 10, 0, 0, 0, time.UTC)))
+	}
+}
+
+func TestQuartzSchedule(t *testing.T) {
+	assert := assert.New(t)
+
+	tt := []string{
+		"*/15 * * * * ?",
+		"0,30 0/15 9-17 ? * MON-FRI",
+		"59 59 23 L * ?",
+		"10-12 0 10 ? * 6#3 2024",
+	}
+
+	from := time.Date(2022, 10, 10, 10, 0, 20, 0, time.UTC)
+
+	for _, exp := range tt {
+		cron, err := cronparse.ParseQuartz(exp)
+		assert.NoError(err)
+		schedule, err := cron.Compile()
+		assert.NoError(err)
+		assert.Equal(cron.NextN(from, 20), schedule.NextN(from, 20), exp)
+	}
+}
+
+func TestQuartzNextIn(t *testing.T) {
+	assert := assert.New(t)
+	ny := loadLocation(t, "America/New_York")
+	cron, err := cronparse.ParseQuartz("0/30 30 1 * * ?")
+	assert.NoError(err)
+
+	// 01:30-01:59 is repeated on 2022-11-06 and only its first occurrence runs
+	expected := []time.Time{
+		time.Date(2022, 11, 6, 5, 30, 0, 0, time.UTC),
+		time.Date(2022, 11, 6, 5, 30, 30, 0, time.UTC),
+		time.Date(2022, 11, 7, 6, 30, 0, 0, time.UTC),
+	}
+
+	schedule := cron.NextNIn(time.Date(2022, 11, 6, 0, 0, 0, 0, ny), ny, 3)
+
+	if assert.Equal(len(expected), len(schedule)) {
+		for i := range expected {
+			assert.True(expected[i].Equal(schedule[i]), schedule[i])
+		}
+	}
+}
+
+func TestQuartzConvert(t *testing.T) {
+	assert := assert.New(t)
+	cron, err := cronparse.ParseQuartz("0 0/15 9-17 ? * MON-FRI")
+	assert.NoError(err)
+
+	s, err := cron.ToQuartz()
+	assert.NoError(err)
+	assert.Equal("0 0/15 9-17 ? * MON-FRI", s)
+
+	s, err = cron.ToUnixCron()
+	assert.NoError(err)
+	assert.Equal("0-59/15 9-17 * * MON-FRI", s)
+
+	cron, err = cronparse.ParseQuartz("*/10 * * * * ?")
+	assert.NoError(err)
+	_, err = cron.ToUnixCron()
+	assert.EqualError(err, `cannot convert Seconds "*/10" to Unix cron: seconds are not supported`)
+}
+
+func TestQuartzDescribe(t *testing.T) {
+	assert := assert.New(t)
+
+	tt := []struct {
+		exp      string
+		expected string
+	}{
+		{"0 0/15 9-17 ? * MON-FRI", "Every 15 minutes, between 09:00 and 17:59, Monday through Friday"},
+		{"*/30 * * * * ?", "Every 30 seconds"},
+		{"15 0 10 * * ?", "At second 15 past the minute, at minute 0 past the hour, between 10:00 and 10:59"},
+	}
+
+	for _, t := range tt {
+		cron, err := cronparse.ParseQuartz(t.exp)
+		assert.NoError(err)
+		assert.Equal(t.expected, cron.Describe(), t.exp)
+	}
+}
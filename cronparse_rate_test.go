@@ -0,0 +1,178 @@
+package cronparse_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/winebarrel/cronparse"
+)
+
+func TestParseRate(t *testing.T) {
+	assert := assert.New(t)
+
+	tt := []struct {
+		exp      string
+		expected *cronparse.RateExpression
+		interval time.Duration
+	}{
+		{"rate(1 minute)", &cronparse.RateExpression{Value: 1, Unit: "minute"}, time.Minute},
+		{"rate(5 minutes)", &cronparse.RateExpression{Value: 5, Unit: "minutes"}, 5 * time.Minute},
+		{"rate(1 hour)", &cronparse.RateExpression{Value: 1, Unit: "hour"}, time.Hour},
+		{"rate(12 hours)", &cronparse.RateExpression{Value: 12, Unit: "hours"}, 12 * time.Hour},
+		{"rate(1 day)", &cronparse.RateExpression{Value: 1, Unit: "day"}, 24 * time.Hour},
+		{"rate(7 days)", &cronparse.RateExpression{Value: 7, Unit: "days"}, 7 * 24 * time.Hour},
+	}
+
+	for _, t := range tt {
+		rate, err := cronparse.ParseRate(t.exp)
+		assert.NoError(err, t.exp)
+		assert.Equal(t.expected, rate, t.exp)
+		assert.Equal(t.exp, rate.String())
+		assert.Equal(t.interval, rate.Interval(), t.exp)
+	}
+}
+
+func TestParseRateError(t *testing.T) {
+	assert := assert.New(t)
+
+	tt := []struct {
+		exp      string
+		expected error
+	}{
+		{"rate(0 minutes)", cronparse.ErrRateValue},
+		{"rate(1 minutes)", cronparse.ErrRatePlural},
+		{"rate(5 minute)", cronparse.ErrRatePlural},
+		{"rate(1 hours)", cronparse.ErrRatePlural},
+		{"rate(2 day)", cronparse.ErrRatePlural},
+		{"rate(2 weeks)", cronparse.ErrRateUnit},
+		{"rate(2 Minutes)", cronparse.ErrRateUnit},
+	}
+
+	for _, t := range tt {
+		_, err := cronparse.ParseRate(t.exp)
+		assert.ErrorIs(err, t.expected, t.exp)
+	}
+
+	for _, exp := range []string{"rate(5)", "rate(minutes)", "rate 5 minutes", "5 minutes", "rate(-1 minutes)"} {
+		_, err := cronparse.ParseRate(exp)
+		assert.Error(err, exp)
+	}
+}
+
+func TestRateMatch(t *testing.T) {
+	assert := assert.New(t)
+	rate, err := cronparse.ParseRate("rate(15 minutes)")
+	assert.NoError(err)
+	rate.Anchor = time.Date(2022, 10, 10, 9, 5, 30, 0, time.UTC)
+
+	tt := []struct {
+		tm       time.Time
+		expected bool
+	}{
+		{time.Date(2022, 10, 10, 8, 50, 0, 0, time.UTC), false},
+		{time.Date(2022, 10, 10, 9, 5, 0, 0, time.UTC), true},
+		{time.Date(2022, 10, 10, 9, 5, 59, 0, time.UTC), true},
+		{time.Date(2022, 10, 10, 9, 10, 0, 0, time.UTC), false},
+		{time.Date(2022, 10, 10, 9, 20, 0, 0, time.UTC), true},
+		{time.Date(2022, 10, 11, 9, 5, 0, 0, time.UTC), true},
+		{time.Date(2022, 10, 10, 18, 20, 0, 0, time.FixedZone("JST", 9*60*60)), true},
+	}
+
+	for _, t := range tt {
+		assert.Equal(t.expected, rate.Match(t.tm), t.tm)
+	}
+}
+
+func TestRateNextN(t *testing.T) {
+	assert := assert.New(t)
+
+	tt := []struct {
+		exp      string
+		anchor   time.Time
+		from     time.Time
+		expected []time.Time
+	}{
+		{
+			exp:    "rate(5 minutes)",
+			from:   time.Date(2022, 10, 10, 0, 1, 0, 0, time.UTC),
+			anchor: time.Time{},
+			expected: []time.Time{
+				time.Date(2022, 10, 10, 0, 5, 0, 0, time.UTC),
+				time.Date(2022, 10, 10, 0, 10, 0, 0, time.UTC),
+				time.Date(2022, 10, 10, 0, 15, 0, 0, time.UTC),
+			},
+		},
+		{
+			exp:    "rate(5 minutes)",
+			from:   time.Date(2022, 10, 10, 0, 5, 30, 0, time.UTC),
+			anchor: time.Time{},
+			expected: []time.Time{
+				time.Date(2022, 10, 10, 0, 5, 0, 0, time.UTC),
+				time.Date(2022, 10, 10, 0, 10, 0, 0, time.UTC),
+				time.Date(2022, 10, 10, 0, 15, 0, 0, time.UTC),
+			},
+		},
+		{
+			exp:    "rate(1 hour)",
+			from:   time.Date(2022, 10, 10, 0, 0, 0, 0, time.UTC),
+			anchor: time.Date(2022, 10, 1, 12, 34, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2022, 10, 10, 0, 34, 0, 0, time.UTC),
+				time.Date(2022, 10, 10, 1, 34, 0, 0, time.UTC),
+				time.Date(2022, 10, 10, 2, 34, 0, 0, time.UTC),
+			},
+		},
+		{
+			exp:    "rate(7 days)",
+			from:   time.Date(2022, 10, 10, 0, 0, 0, 0, time.UTC),
+			anchor: time.Date(2022, 11, 1, 9, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2022, 11, 1, 9, 0, 0, 0, time.UTC),
+				time.Date(2022, 11, 8, 9, 0, 0, 0, time.UTC),
+				time.Date(2022, 11, 15, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			exp:    "rate(1 day)",
+			from:   time.Date(2022, 10, 10, 0, 0, 0, 0, time.FixedZone("JST", 9*60*60)),
+			anchor: time.Date(2022, 10, 1, 9, 0, 0, 0, time.UTC),
+			expected: []time.Time{
+				time.Date(2022, 10, 10, 18, 0, 0, 0, time.FixedZone("JST", 9*60*60)),
+				time.Date(2022, 10, 11, 18, 0, 0, 0, time.FixedZone("JST", 9*60*60)),
+				time.Date(2022, 10, 12, 18, 0, 0, 0, time.FixedZone("JST", 9*60*60)),
+			},
+		},
+	}
+
+	for _, t := range tt {
+		rate, err := cronparse.ParseRate(t.exp)
+		assert.NoError(err)
+		rate.Anchor = t.anchor
+		schedule := rate.NextN(t.from, 3)
+		assert.Equal(len(t.expected), len(schedule), t)
+
+		for i := range t.expected {
+			assert.True(t.expected[i].Equal(schedule[i]), t.exp, schedule[i])
+			assert.Equal(t.from.Location(), schedule[i].Location())
+		}
+
+		assert.True(t.expected[0].Equal(rate.Next(t.from)))
+	}
+}
+
+func TestScheduleExpression(t *testing.T) {
+	assert := assert.New(t)
+	from := time.Date(2022, 10, 10, 9, 58, 0, 0, time.UTC)
+
+	cron, err := cronparse.Parse("0 10 * * ? *")
+	assert.NoError(err)
+	rate, err := cronparse.ParseRate("rate(1 day)")
+	assert.NoError(err)
+	rate.Anchor = time.Date(2022, 10, 1, 10, 0, 0, 0, time.UTC)
+
+	for _, s := range []cronparse.ScheduleExpression{cron, rate} {
+		assert.Equal(time.Date(2022, 10, 10, 10, 0, 0, 0, time.UTC), s.Next(from), s.String())
+		assert.True(s.Match(time.Date(2022, 10, 11, 10, 0, 0, 0, time.UTC)), s.String())
+	}
+}
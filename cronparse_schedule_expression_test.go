@@ -0,0 +1,98 @@
+package cronparse_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/winebarrel/cronparse"
+)
+
+func TestParseScheduleExpression(t *testing.T) {
+	assert := assert.New(t)
+
+	tt := []struct {
+		exp      string
+		expected cronparse.ScheduleExpression
+	}{
+		{"cron(0 10 * * ? *)", &cronparse.CronExpression{}},
+		{"cron(0/5 8-17 ? * MON-FRI *)", &cronparse.CronExpression{}},
+		{"rate(5 minutes)", &cronparse.RateExpression{}},
+		{"rate(1 day)", &cronparse.RateExpression{}},
+		{"at(2024-05-01T10:00:00)", &cronparse.AtExpression{}},
+		{"0 10 * * ? *", &cronparse.Expression{}},
+	}
+
+	for _, t := range tt {
+		s, err := cronparse.ParseScheduleExpression(t.exp)
+
+		if assert.NoError(err, t.exp) {
+			assert.IsType(t.expected, s, t.exp)
+			assert.Equal(t.exp, s.String())
+		}
+	}
+}
+
+func TestParseScheduleExpressionCron(t *testing.T) {
+	assert := assert.New(t)
+	s, err := cronparse.ParseScheduleExpression("cron(0 10 * * ? *)")
+	assert.NoError(err)
+	cron := s.(*cronparse.CronExpression)
+	assert.Equal(10, cron.Hours.Exps[0].Number.Value)
+	assert.Equal("0 10 * * ? *", cron.Expression.String())
+	assert.True(s.Match(time.Date(2022, 11, 3, 10, 0, 0, 0, time.UTC)))
+	assert.Equal(time.Date(2022, 11, 4, 10, 0, 0, 0, time.UTC), s.Next(time.Date(2022, 11, 3, 11, 0, 0, 0, time.UTC)))
+}
+
+func TestParseScheduleExpressionError(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := cronparse.ParseScheduleExpression("rate(5 minute)")
+	assert.ErrorIs(err, cronparse.ErrRatePlural)
+
+	_, err = cronparse.ParseScheduleExpression("at(2024-05-01)")
+	assert.ErrorIs(err, cronparse.ErrAtFormat)
+
+	_, err = cronparse.ParseScheduleExpression("cron(0 10 * * MON *)")
+	assert.ErrorIs(err, cronparse.ErrDayFieldConflict)
+
+	tt := []struct {
+		exp      string
+		offset   int
+		expected string
+	}{
+		{"cron(0 25 * * ? *)", 7, `column 8 (Hours): "25" is out of range (0-23); hours must be 0-23`},
+		{"cron(0 10 ? * X *)", 14, `column 15 (DayOfWeek): unexpected token "X"; day-of-week must be 1-7 (1 is SUN), L, nL or n#m; day-of-week names are SUN..SAT`},
+		{"cron(0 10 * * ? *", 17, `column 18 (Year): missing ")"`},
+		{"cron(0 10 * *", 13, `column 14 (DayOfWeek): missing ")"`},
+		{"cron( 0 10 * * ? *)", 5, `column 6 (Minutes): unexpected leading whitespace; an expression has 6 fields: minutes hours day-of-month month day-of-week year`},
+	}
+
+	for _, t := range tt {
+		_, err := cronparse.ParseScheduleExpression(t.exp)
+		var perr *cronparse.ParseError
+
+		if assert.True(errors.As(err, &perr), t.exp) {
+			assert.Equal(t.offset, perr.Offset, t.exp)
+			assert.Equal(t.expected, perr.Error(), t.exp)
+		}
+	}
+}
+
+func TestParseScheduleExpressionErrorIsNil(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, exp := range []string{
+		"rate(0 minutes)",
+		"at(2024-13-01T00:00:00)",
+		"0 0 * * * *",
+		"cron(0 0 * * * *)",
+		"cron(0 10 * * ? *",
+	} {
+		s, err := cronparse.ParseScheduleExpression(exp)
+		assert.Error(err, exp)
+		assert.Nil(s, exp)
+		assert.True(s == nil, exp)
+	}
+}
@@ -0,0 +1,162 @@
+package cronparse_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/winebarrel/cronparse"
+)
+
+var scheduleTestExps = []string{
+	"0 10 * * ? *",
+	"*/5 * * * ? *",
+	"0/15 9-17 ? * MON-FRI *",
+	"32 6 13,16 * ? *",
+	"33 7 ? * FRI *",
+	"34 8 15 NOV ? *",
+	"35 9 17 DEC ? 2023",
+	"35 9 17 DEC ? 2020",
+	"0 12 1/5 * ? *",
+	"0 10 L * ? *",
+	"0 10 3W * ? *",
+	"15 10 ? * 6#3 *",
+	"0 12 ? * L *",
+	"59 23 31 * ? *",
+	"0 0 29 FEB ? *",
+	"0 0 1 JAN ? 2024/11",
+	"10,44 14 ? 3 WED *",
+	"0 18 ? * TUE-SUN *",
+}
+
+func TestScheduleNextN(t *testing.T) {
+	assert := assert.New(t)
+
+	froms := []time.Time{
+		time.Date(2022, 10, 10, 0, 0, 0, 0, time.UTC),
+		time.Date(2022, 10, 13, 6, 33, 0, 0, time.UTC),
+		time.Date(2022, 12, 31, 23, 59, 0, 0, time.UTC),
+		time.Date(2023, 2, 28, 10, 1, 0, 0, time.UTC),
+		time.Date(2199, 12, 31, 23, 0, 0, 0, time.UTC),
+	}
+
+	for _, exp := range scheduleTestExps {
+		cron, err := cronparse.Parse(exp)
+		assert.NoError(err)
+		sched, err := cron.Compile()
+		assert.NoError(err)
+
+		for _, from := range froms {
+			assert.Equal(cron.NextN(from, 50), sched.NextN(from, 50), exp, from)
+			assert.Equal(cron.Next(from), sched.Next(from), exp, from)
+		}
+	}
+}
+
+func TestScheduleNextBefore1970(t *testing.T) {
+	assert := assert.New(t)
+	cron, err := cronparse.Parse("0 18 ? * TUE-SUN *")
+	assert.NoError(err)
+	sched, err := cron.Compile()
+	assert.NoError(err)
+	assert.Equal(time.Date(1970, 1, 1, 18, 0, 0, 0, time.UTC), sched.Next(time.Date(1960, 1, 1, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestScheduleMatch(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, exp := range scheduleTestExps {
+		cron, err := cronparse.Parse(exp)
+		assert.NoError(err)
+		sched, err := cron.Compile()
+		assert.NoError(err)
+
+		for tm := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC); tm.Year() < 2025; tm = tm.Add(13 * time.Hour) {
+			assert.Equal(cron.Match(tm), sched.Match(tm), exp, tm)
+			tm2 := time.Date(tm.Year(), tm.Month(), tm.Day(), tm.Hour(), 0, 0, 0, time.UTC)
+			assert.Equal(cron.Match(tm2), sched.Match(tm2), exp, tm2)
+		}
+	}
+}
+
+func TestScheduleImmutable(t *testing.T) {
+	assert := assert.New(t)
+	cron, err := cronparse.Parse("0 10 L * ? *")
+	assert.NoError(err)
+	sched, err := cron.Compile()
+	assert.NoError(err)
+
+	cron.Hours.Exps[0].Number.Value = 11
+	cron.DayOfMonth.Exps[0] = &cronparse.DayOfMonthExp{CommonExp: cronparse.CommonExp{Number: &cronparse.Number{Value: 1}}}
+
+	assert.Equal(time.Date(2022, 10, 31, 10, 0, 0, 0, time.UTC), sched.Next(time.Date(2022, 10, 10, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestCompileError(t *testing.T) {
+	assert := assert.New(t)
+	cron, err := cronparse.Parser.ParseString("", "0 10 * * * *")
+	assert.NoError(err)
+	_, err = cron.Compile()
+	assert.ErrorIs(err, cronparse.ErrDayFieldConflict)
+}
+
+func benchmarkFrom() time.Time {
+	return time.Date(2022, 10, 10, 10, 15, 0, 0, time.UTC)
+}
+
+func BenchmarkExpressionNextN(b *testing.B) {
+	cron, _ := cronparse.Parse("0/15 9-17 ? * MON-FRI *")
+	from := benchmarkFrom()
+
+	for i := 0; i < b.N; i++ {
+		cron.NextN(from, 10)
+	}
+}
+
+func BenchmarkScheduleNextN(b *testing.B) {
+	cron, _ := cronparse.Parse("0/15 9-17 ? * MON-FRI *")
+	sched, _ := cron.Compile()
+	from := benchmarkFrom()
+
+	for i := 0; i < b.N; i++ {
+		sched.NextN(from, 10)
+	}
+}
+
+func BenchmarkExpressionNextSparse(b *testing.B) {
+	cron, _ := cronparse.Parse("0 0 29 FEB ? *")
+	from := benchmarkFrom()
+
+	for i := 0; i < b.N; i++ {
+		cron.Next(from)
+	}
+}
+
+func BenchmarkScheduleNextSparse(b *testing.B) {
+	cron, _ := cronparse.Parse("0 0 29 FEB ? *")
+	sched, _ := cron.Compile()
+	from := benchmarkFrom()
+
+	for i := 0; i < b.N; i++ {
+		sched.Next(from)
+	}
+}
+
+func BenchmarkExpressionMatch(b *testing.B) {
+	cron, _ := cronparse.Parse("0/15 9-17 ? * MON-FRI *")
+	tm := benchmarkFrom()
+
+	for i := 0; i < b.N; i++ {
+		cron.Match(tm)
+	}
+}
+
+func BenchmarkScheduleMatch(b *testing.B) {
+	cron, _ := cronparse.Parse("0/15 9-17 ? * MON-FRI *")
+	sched, _ := cron.Compile()
+	tm := benchmarkFrom()
+
+	for i := 0; i < b.N; i++ {
+		sched.Match(tm)
+	}
+}
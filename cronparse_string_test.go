@@ -43,6 +43,64 @@ func TestMonthRangeToString(t *testing.T) {
 	assert.Equal("JAN-DEC", x.String())
 }
 
+func TestNumberRangeIncrementToString(t *testing.T) {
+	assert := assert.New(t)
+	x := &cronparse.NumberRangeIncrement{From: 10, To: 40, Buttom: 5}
+	assert.Equal("10-40/5", x.String())
+}
+
+func TestWeekRangeIncrementToString(t *testing.T) {
+	assert := assert.New(t)
+	x := &cronparse.WeekRangeIncrement{From: "MON", To: "FRI", Buttom: 2}
+	assert.Equal("MON-FRI/2", x.String())
+}
+
+func TestRangeIncrementWrapAroundToString(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, exp := range []string{
+		"* 22-2/2 * * ? *",
+		"* * ? * MON-SUN/2 *",
+		"* * ? * 6-2/2 *",
+		"* * * NOV-FEB/2 ? *",
+	} {
+		cron, err := cronparse.Parse(exp)
+
+		if assert.NoError(err, exp) {
+			assert.Equal(exp, cron.String())
+		}
+	}
+
+	x := &cronparse.NumberRangeIncrement{From: 22, To: 2, Buttom: 2}
+	assert.Equal("22-2/2", x.String())
+	y := &cronparse.WeekRangeIncrement{From: "MON", To: "SUN", Buttom: 2}
+	assert.Equal("MON-SUN/2", y.String())
+}
+
+func TestMonthRangeIncrementToString(t *testing.T) {
+	assert := assert.New(t)
+	x := &cronparse.MonthRangeIncrement{From: "JAN", To: "DEC", Buttom: 3}
+	assert.Equal("JAN-DEC/3", x.String())
+}
+
+func TestLastWeekdayOfMonthToString(t *testing.T) {
+	assert := assert.New(t)
+	x := &cronparse.LastWeekdayOfMonth{}
+	assert.Equal("LW", x.String())
+}
+
+func TestLastOfMonthOffsetToString(t *testing.T) {
+	assert := assert.New(t)
+	x := &cronparse.LastOfMonthOffset{Offset: 3}
+	assert.Equal("L-3", x.String())
+}
+
+func TestLastInstanceToString(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal("6L", (&cronparse.LastInstance{DayOfWeek: 6}).String())
+	assert.Equal("FRIL", (&cronparse.LastInstance{Name: "FRI"}).String())
+}
+
 func TestAllToString(t *testing.T) {
 	assert := assert.New(t)
 	x := &cronparse.All{}
@@ -52,6 +52,28 @@ func TestMatchNumberRange(t *testing.T) {
 	}
 }
 
+func TestMatchNumberRangeWrapAround(t *testing.T) {
+	assert := assert.New(t)
+	x := &cronparse.NumberRange{From: 22, To: 2}
+
+	tt := []struct {
+		num      int
+		expected bool
+	}{
+		{0, true},
+		{1, true},
+		{2, true},
+		{3, false},
+		{21, false},
+		{22, true},
+		{23, true},
+	}
+
+	for _, t := range tt {
+		assert.Equal(t.expected, x.Match(t.num), t.num)
+	}
+}
+
 func TestMatchWeekRange1(t *testing.T) {
 	assert := assert.New(t)
 	x := &cronparse.WeekRange{From: "SUN", To: "FRI"}
@@ -124,6 +146,160 @@ func TestMatchMonthRange(t *testing.T) {
 	}
 }
 
+func TestMatchMonthRangeWrapAround(t *testing.T) {
+	assert := assert.New(t)
+	x := &cronparse.MonthRange{From: "NOV", To: "FEB"}
+
+	tt := []struct {
+		name     time.Month
+		expected bool
+	}{
+		{time.January, true},
+		{time.February, true},
+		{time.March, false},
+		{time.October, false},
+		{time.November, true},
+		{time.December, true},
+	}
+
+	for _, t := range tt {
+		assert.Equal(t.expected, x.Match(t.name), t.name)
+	}
+}
+
+func TestMatchNumberRangeIncrement(t *testing.T) {
+	assert := assert.New(t)
+	x := &cronparse.NumberRangeIncrement{From: 10, To: 40, Buttom: 15}
+
+	tt := []struct {
+		num      int
+		expected bool
+	}{
+		{0, false},
+		{9, false},
+		{10, true},
+		{11, false},
+		{25, true},
+		{40, true},
+		{41, false},
+		{55, false},
+	}
+
+	for _, t := range tt {
+		assert.Equal(t.expected, x.Match(t.num, cronparse.FieldMinutes), t.num)
+	}
+}
+
+func TestMatchNumberRangeIncrementWrapAround(t *testing.T) {
+	assert := assert.New(t)
+	x := &cronparse.NumberRangeIncrement{From: 22, To: 2, Buttom: 2}
+
+	tt := []struct {
+		num      int
+		expected bool
+	}{
+		{20, false},
+		{21, false},
+		{22, true},
+		{23, false},
+		{0, true},
+		{1, false},
+		{2, true},
+		{3, false},
+	}
+
+	for _, t := range tt {
+		assert.Equal(t.expected, x.Match(t.num, cronparse.FieldHours), t.num)
+	}
+}
+
+func TestMatchWeekRangeIncrement(t *testing.T) {
+	assert := assert.New(t)
+	x := &cronparse.WeekRangeIncrement{From: "MON", To: "FRI", Buttom: 2}
+
+	tt := []struct {
+		w        time.Weekday
+		expected bool
+	}{
+		{time.Sunday, false},
+		{time.Monday, true},
+		{time.Tuesday, false},
+		{time.Wednesday, true},
+		{time.Thursday, false},
+		{time.Friday, true},
+		{time.Saturday, false},
+	}
+
+	for _, t := range tt {
+		assert.Equal(t.expected, x.Match(t.w), t.w)
+	}
+}
+
+func TestMatchWeekRangeIncrementWrapAround(t *testing.T) {
+	assert := assert.New(t)
+	x := &cronparse.WeekRangeIncrement{From: "MON", To: "SUN", Buttom: 2}
+
+	tt := []struct {
+		w        time.Weekday
+		expected bool
+	}{
+		{time.Sunday, true},
+		{time.Monday, true},
+		{time.Tuesday, false},
+		{time.Wednesday, true},
+		{time.Thursday, false},
+		{time.Friday, true},
+		{time.Saturday, false},
+	}
+
+	for _, t := range tt {
+		assert.Equal(t.expected, x.Match(t.w), t.w)
+	}
+}
+
+func TestMatchMonthRangeIncrement(t *testing.T) {
+	assert := assert.New(t)
+	x := &cronparse.MonthRangeIncrement{From: "FEB", To: "NOV", Buttom: 3}
+
+	tt := []struct {
+		name     time.Month
+		expected bool
+	}{
+		{time.January, false},
+		{time.February, true},
+		{time.March, false},
+		{time.May, true},
+		{time.August, true},
+		{time.November, true},
+		{time.December, false},
+	}
+
+	for _, t := range tt {
+		assert.Equal(t.expected, x.Match(t.name), t.name)
+	}
+}
+
+func TestMatchMonthRangeIncrementWrapAround(t *testing.T) {
+	assert := assert.New(t)
+	x := &cronparse.MonthRangeIncrement{From: "NOV", To: "FEB", Buttom: 2}
+
+	tt := []struct {
+		name     time.Month
+		expected bool
+	}{
+		{time.October, false},
+		{time.November, true},
+		{time.December, false},
+		{time.January, true},
+		{time.February, false},
+		{time.March, false},
+	}
+
+	for _, t := range tt {
+		assert.Equal(t.expected, x.Match(t.name), t.name)
+	}
+}
+
 func TestMatchAll(t *testing.T) {
 	assert := assert.New(t)
 	x := &cronparse.All{}
@@ -263,6 +439,66 @@ func TestMatchWeekday(t *testing.T) {
 	}
 }
 
+func TestMatchWeekdayMonthBoundary(t *testing.T) {
+	assert := assert.New(t)
+
+	tt := []struct {
+		tm       time.Time
+		w        *cronparse.Weekday
+		expected bool
+	}{
+		// 2022-10-01 is a Saturday
+		{time.Date(2022, 9, 30, 9, 0, 0, 0, time.UTC), &cronparse.Weekday{Value: 1}, false},
+		{time.Date(2022, 10, 3, 9, 0, 0, 0, time.UTC), &cronparse.Weekday{Value: 1}, true},
+		// 2023-12-31 is a Sunday
+		{time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC), &cronparse.Weekday{Value: 31}, false},
+		{time.Date(2023, 12, 29, 9, 0, 0, 0, time.UTC), &cronparse.Weekday{Value: 31}, true},
+		// April has no 31st
+		{time.Date(2023, 4, 28, 9, 0, 0, 0, time.UTC), &cronparse.Weekday{Value: 31}, false},
+		{time.Date(2023, 5, 1, 9, 0, 0, 0, time.UTC), &cronparse.Weekday{Value: 31}, false},
+		// February 2024 has 29 days and February 2023 has 28
+		{time.Date(2024, 2, 29, 9, 0, 0, 0, time.UTC), &cronparse.Weekday{Value: 29}, true},
+		{time.Date(2023, 2, 28, 9, 0, 0, 0, time.UTC), &cronparse.Weekday{Value: 29}, false},
+		{time.Date(2023, 3, 1, 9, 0, 0, 0, time.UTC), &cronparse.Weekday{Value: 29}, false},
+	}
+
+	for _, t := range tt {
+		assert.Equal(t.expected, t.w.Match(t.tm), t)
+	}
+}
+
+func TestMatchWeekdayEveryMonthLayout(t *testing.T) {
+	assert := assert.New(t)
+	// 2000 to 2029 has every combination of the weekday of the 1st and the length of the month
+	for year := 2000; year < 2030; year++ {
+		for month := time.January; month <= time.December; month++ {
+			first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+			days := first.AddDate(0, 1, -1).Day()
+
+			for n := 1; n <= 31; n++ {
+				w := &cronparse.Weekday{Value: n}
+				matches := []time.Time{}
+
+				for d := first; d.Month() == month; d = d.AddDate(0, 0, 1) {
+					if w.Match(d) {
+						matches = append(matches, d)
+					}
+				}
+
+				if n > days {
+					assert.Empty(matches, "%dW %s", n, first.Format("2006-01"))
+					continue
+				}
+
+				if assert.Len(matches, 1, "%dW %s", n, first.Format("2006-01")) {
+					wd := matches[0].Weekday()
+					assert.True(time.Monday <= wd && wd <= time.Friday, "%dW %s", n, matches[0])
+				}
+			}
+		}
+	}
+}
+
 func TestMatchInstance(t *testing.T) {
 	assert := assert.New(t)
 
@@ -271,24 +507,31 @@ func TestMatchInstance(t *testing.T) {
 		i        *cronparse.Instance
 		expected bool
 	}{
-		{time.Date(2022, 11, 6, 9, 0, 0, 0, time.UTC), &cronparse.Instance{DayOfWeek: int(time.Monday), NthDayOfWeek: 1}, false},
-		{time.Date(2022, 11, 7, 9, 0, 0, 0, time.UTC), &cronparse.Instance{DayOfWeek: int(time.Monday), NthDayOfWeek: 1}, true},
-		{time.Date(2022, 11, 8, 9, 0, 0, 0, time.UTC), &cronparse.Instance{DayOfWeek: int(time.Monday), NthDayOfWeek: 1}, false},
-		{time.Date(2022, 11, 13, 9, 0, 0, 0, time.UTC), &cronparse.Instance{DayOfWeek: int(time.Monday), NthDayOfWeek: 2}, false},
-		{time.Date(2022, 11, 14, 9, 0, 0, 0, time.UTC), &cronparse.Instance{DayOfWeek: int(time.Monday), NthDayOfWeek: 2}, true},
-		{time.Date(2022, 11, 15, 9, 0, 0, 0, time.UTC), &cronparse.Instance{DayOfWeek: int(time.Monday), NthDayOfWeek: 2}, false},
-		{time.Date(2022, 11, 20, 9, 0, 0, 0, time.UTC), &cronparse.Instance{DayOfWeek: int(time.Monday), NthDayOfWeek: 3}, false},
-		{time.Date(2022, 11, 21, 9, 0, 0, 0, time.UTC), &cronparse.Instance{DayOfWeek: int(time.Monday), NthDayOfWeek: 3}, true},
-		{time.Date(2022, 11, 22, 9, 0, 0, 0, time.UTC), &cronparse.Instance{DayOfWeek: int(time.Monday), NthDayOfWeek: 3}, false},
-		{time.Date(2022, 11, 7, 9, 0, 0, 0, time.UTC), &cronparse.Instance{DayOfWeek: int(time.Tuesday), NthDayOfWeek: 1}, false},
-		{time.Date(2022, 11, 1, 9, 0, 0, 0, time.UTC), &cronparse.Instance{DayOfWeek: int(time.Tuesday), NthDayOfWeek: 1}, true},
-		{time.Date(2022, 11, 2, 9, 0, 0, 0, time.UTC), &cronparse.Instance{DayOfWeek: int(time.Tuesday), NthDayOfWeek: 1}, false},
-		{time.Date(2022, 11, 7, 9, 0, 0, 0, time.UTC), &cronparse.Instance{DayOfWeek: int(time.Tuesday), NthDayOfWeek: 2}, false},
-		{time.Date(2022, 11, 8, 9, 0, 0, 0, time.UTC), &cronparse.Instance{DayOfWeek: int(time.Tuesday), NthDayOfWeek: 2}, true},
-		{time.Date(2022, 11, 9, 9, 0, 0, 0, time.UTC), &cronparse.Instance{DayOfWeek: int(time.Tuesday), NthDayOfWeek: 2}, false},
-		{time.Date(2022, 11, 14, 9, 0, 0, 0, time.UTC), &cronparse.Instance{DayOfWeek: int(time.Tuesday), NthDayOfWeek: 3}, false},
-		{time.Date(2022, 11, 15, 9, 0, 0, 0, time.UTC), &cronparse.Instance{DayOfWeek: int(time.Tuesday), NthDayOfWeek: 3}, true},
-		{time.Date(2022, 11, 16, 9, 0, 0, 0, time.UTC), &cronparse.Instance{DayOfWeek: int(time.Tuesday), NthDayOfWeek: 3}, false},
+		{time.Date(2022, 11, 6, 9, 0, 0, 0, time.UTC), &cronparse.Instance{DayOfWeek: 2, NthDayOfWeek: 1}, false},
+		{time.Date(2022, 11, 7, 9, 0, 0, 0, time.UTC), &cronparse.Instance{DayOfWeek: 2, NthDayOfWeek: 1}, true},
+		{time.Date(2022, 11, 8, 9, 0, 0, 0, time.UTC), &cronparse.Instance{DayOfWeek: 2, NthDayOfWeek: 1}, false},
+		{time.Date(2022, 11, 13, 9, 0, 0, 0, time.UTC), &cronparse.Instance{DayOfWeek: 2, NthDayOfWeek: 2}, false},
+		{time.Date(2022, 11, 14, 9, 0, 0, 0, time.UTC), &cronparse.Instance{DayOfWeek: 2, NthDayOfWeek: 2}, true},
+		{time.Date(2022, 11, 15, 9, 0, 0, 0, time.UTC), &cronparse.Instance{DayOfWeek: 2, NthDayOfWeek: 2}, false},
+		{time.Date(2022, 11, 20, 9, 0, 0, 0, time.UTC), &cronparse.Instance{DayOfWeek: 2, NthDayOfWeek: 3}, false},
+		{time.Date(2022, 11, 21, 9, 0, 0, 0, time.UTC), &cronparse.Instance{DayOfWeek: 2, NthDayOfWeek: 3}, true},
+		{time.Date(2022, 11, 22, 9, 0, 0, 0, time.UTC), &cronparse.Instance{DayOfWeek: 2, NthDayOfWeek: 3}, false},
+		{time.Date(2022, 11, 7, 9, 0, 0, 0, time.UTC), &cronparse.Instance{DayOfWeek: 3, NthDayOfWeek: 1}, false},
+		{time.Date(2022, 11, 1, 9, 0, 0, 0, time.UTC), &cronparse.Instance{DayOfWeek: 3, NthDayOfWeek: 1}, true},
+		{time.Date(2022, 11, 2, 9, 0, 0, 0, time.UTC), &cronparse.Instance{DayOfWeek: 3, NthDayOfWeek: 1}, false},
+		{time.Date(2022, 11, 7, 9, 0, 0, 0, time.UTC), &cronparse.Instance{DayOfWeek: 3, NthDayOfWeek: 2}, false},
+		{time.Date(2022, 11, 8, 9, 0, 0, 0, time.UTC), &cronparse.Instance{DayOfWeek: 3, NthDayOfWeek: 2}, true},
+		{time.Date(2022, 11, 9, 9, 0, 0, 0, time.UTC), &cronparse.Instance{DayOfWeek: 3, NthDayOfWeek: 2}, false},
+		{time.Date(2022, 11, 14, 9, 0, 0, 0, time.UTC), &cronparse.Instance{DayOfWeek: 3, NthDayOfWeek: 3}, false},
+		{time.Date(2022, 11, 15, 9, 0, 0, 0, time.UTC), &cronparse.Instance{DayOfWeek: 3, NthDayOfWeek: 3}, true},
+		{time.Date(2022, 11, 16, 9, 0, 0, 0, time.UTC), &cronparse.Instance{DayOfWeek: 3, NthDayOfWeek: 3}, false},
+		// November 2022 has five Tuesdays but only four Mondays
+		{time.Date(2022, 11, 29, 9, 0, 0, 0, time.UTC), &cronparse.Instance{DayOfWeek: 3, NthDayOfWeek: 5}, true},
+		{time.Date(2022, 11, 28, 9, 0, 0, 0, time.UTC), &cronparse.Instance{DayOfWeek: 2, NthDayOfWeek: 5}, false},
+		{time.Date(2022, 11, 7, 9, 0, 0, 0, time.UTC), &cronparse.Instance{DayOfWeek: 2, NthDayOfWeek: 5}, false},
+		{time.Date(2022, 11, 5, 9, 0, 0, 0, time.UTC), &cronparse.Instance{DayOfWeek: 2, NthDayOfWeek: 5}, false},
+		// 2023-02 has four Mondays, and the day after 28 days would be 2023-03-06
+		{time.Date(2023, 2, 6, 9, 0, 0, 0, time.UTC), &cronparse.Instance{DayOfWeek: 2, NthDayOfWeek: 5}, false},
 	}
 
 	for _, t := range tt {
@@ -296,6 +539,30 @@ func TestMatchInstance(t *testing.T) {
 	}
 }
 
+func TestMatchLastInstance(t *testing.T) {
+	assert := assert.New(t)
+
+	tt := []struct {
+		tm       time.Time
+		l        *cronparse.LastInstance
+		expected bool
+	}{
+		{time.Date(2023, 9, 29, 9, 0, 0, 0, time.UTC), &cronparse.LastInstance{DayOfWeek: 6}, true},
+		{time.Date(2023, 9, 22, 9, 0, 0, 0, time.UTC), &cronparse.LastInstance{DayOfWeek: 6}, false},
+		{time.Date(2023, 9, 30, 9, 0, 0, 0, time.UTC), &cronparse.LastInstance{DayOfWeek: 6}, false},
+		{time.Date(2023, 9, 29, 9, 0, 0, 0, time.UTC), &cronparse.LastInstance{Name: "FRI"}, true},
+		{time.Date(2023, 9, 24, 9, 0, 0, 0, time.UTC), &cronparse.LastInstance{DayOfWeek: 1}, true},
+		{time.Date(2023, 9, 24, 9, 0, 0, 0, time.UTC), &cronparse.LastInstance{Name: "SUN"}, true},
+		{time.Date(2023, 9, 30, 9, 0, 0, 0, time.UTC), &cronparse.LastInstance{DayOfWeek: 7}, true},
+		{time.Date(2024, 2, 29, 9, 0, 0, 0, time.UTC), &cronparse.LastInstance{DayOfWeek: 5}, true},
+		{time.Date(2024, 2, 22, 9, 0, 0, 0, time.UTC), &cronparse.LastInstance{DayOfWeek: 5}, false},
+	}
+
+	for _, t := range tt {
+		assert.Equal(t.expected, t.l.Match(t.tm), t.tm, t.l)
+	}
+}
+
 func TestMatchLastOfMonth(t *testing.T) {
 	assert := assert.New(t)
 
@@ -359,6 +626,49 @@ func TestMatchLastOfMonth(t *testing.T) {
 	}
 }
 
+func TestMatchLastWeekdayOfMonth(t *testing.T) {
+	assert := assert.New(t)
+	x := &cronparse.LastWeekdayOfMonth{}
+
+	tt := []struct {
+		tm       time.Time
+		expected bool
+	}{
+		{time.Date(2024, 1, 31, 9, 0, 0, 0, time.UTC), true},
+		{time.Date(2024, 2, 28, 9, 0, 0, 0, time.UTC), false},
+		{time.Date(2024, 2, 29, 9, 0, 0, 0, time.UTC), true},
+		{time.Date(2020, 2, 28, 9, 0, 0, 0, time.UTC), true},
+		{time.Date(2020, 2, 29, 9, 0, 0, 0, time.UTC), false},
+		{time.Date(2023, 4, 28, 9, 0, 0, 0, time.UTC), true},
+		{time.Date(2023, 4, 30, 9, 0, 0, 0, time.UTC), false},
+	}
+
+	for _, t := range tt {
+		assert.Equal(t.expected, x.Match(t.tm), t.tm)
+	}
+}
+
+func TestMatchLastOfMonthOffset(t *testing.T) {
+	assert := assert.New(t)
+	x := &cronparse.LastOfMonthOffset{Offset: 3}
+
+	tt := []struct {
+		tm       time.Time
+		expected bool
+	}{
+		{time.Date(2023, 1, 28, 9, 0, 0, 0, time.UTC), true},
+		{time.Date(2023, 1, 31, 9, 0, 0, 0, time.UTC), false},
+		{time.Date(2023, 2, 25, 9, 0, 0, 0, time.UTC), true},
+		{time.Date(2024, 2, 25, 9, 0, 0, 0, time.UTC), false},
+		{time.Date(2024, 2, 26, 9, 0, 0, 0, time.UTC), true},
+		{time.Date(2023, 4, 27, 9, 0, 0, 0, time.UTC), true},
+	}
+
+	for _, t := range tt {
+		assert.Equal(t.expected, x.Match(t.tm), t.tm)
+	}
+}
+
 func TestMatchLastOfWeek(t *testing.T) {
 	assert := assert.New(t)
 
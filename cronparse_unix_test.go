@@ -0,0 +1,104 @@
+package cronparse_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/winebarrel/cronparse"
+)
+
+func TestFromUnixCron(t *testing.T) {
+	assert := assert.New(t)
+
+	tt := []struct {
+		crontab  string
+		expected string
+	}{
+		{"* * * * *", "* * * * ? *"},
+		{"*/5 * * * *", "*/5 * * * ? *"},
+		{"0 10 1,15 * *", "0 10 1,15 * ? *"},
+		{"0 10 * JAN-MAR *", "0 10 * JAN-MAR ? *"},
+		{"0 10 L * *", "0 10 L * ? *"},
+		{"0 9 * * 0", "0 9 ? * 1 *"},
+		{"0 9 * * 7", "0 9 ? * 1 *"},
+		{"0 9 * * 6", "0 9 ? * 7 *"},
+		{"0 9 * * 1-5", "0 9 ? * 2-6 *"},
+		{"0 9 * * 1,3,5", "0 9 ? * 2,4,6 *"},
+		{"0 9 * * 5-7", "0 9 ? * 1,6,7 *"},
+		{"0 9 * * 0-6", "0 9 ? * 1-7 *"},
+		{"0 9 * * MON-FRI", "0 9 ? * MON-FRI *"},
+		{"0 9 * * sun", "0 9 ? * sun *"},
+		{"0 9 * * */2", "0 9 ? * */2 *"},
+		{"0 9 * * 1/2", "0 9 ? * 1,2,4,6 *"},
+		{"0 9 * * 1-5/2", "0 9 ? * 2,4,6 *"},
+		{"0 9 * * MON-FRI/2", "0 9 ? * 2,4,6 *"},
+		{"0-30/10 9-17/4 * * *", "0-30/10 9-17/4 * * ? *"},
+		{"0 0 1 JAN-JUN/2 *", "0 0 1 JAN-JUN/2 ? *"},
+		{"@yearly", "0 0 1 1 ? *"},
+		{"@annually", "0 0 1 1 ? *"},
+		{"@monthly", "0 0 1 * ? *"},
+		{"@weekly", "0 0 ? * 1 *"},
+		{"@daily", "0 0 * * ? *"},
+		{"@midnight", "0 0 * * ? *"},
+		{"@HOURLY", "0 * * * ? *"},
+		{"  0 10 * * *  ", "0 10 * * ? *"},
+	}
+
+	for _, t := range tt {
+		cron, err := cronparse.FromUnixCron(t.crontab)
+
+		if assert.NoError(err, t.crontab) {
+			assert.Equal(t.expected, cron.String(), t.crontab)
+		}
+	}
+}
+
+func TestFromUnixCronMatch(t *testing.T) {
+	assert := assert.New(t)
+	// Sunday is 0 in Unix cron and 1 in EventBridge
+	cron, err := cronparse.FromUnixCron("0 9 * * 0")
+	assert.NoError(err)
+	assert.Equal("0 9 ? * 1 *", cron.String())
+	assert.Equal(time.Date(2022, 10, 16, 9, 0, 0, 0, time.UTC), cron.Next(time.Date(2022, 10, 10, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestFromUnixCronError(t *testing.T) {
+	assert := assert.New(t)
+
+	tt := []struct {
+		crontab string
+		err     error
+	}{
+		{"0 10 * *", cronparse.ErrUnixFields},
+		{"0 10 * * * *", cronparse.ErrUnixFields},
+		{"", cronparse.ErrUnixFields},
+		{"@reboot", cronparse.ErrUnixMacro},
+		{"0 10 1 * 1", cronparse.ErrUnixDayFieldConflict},
+		{"0 10 */2 * MON", cronparse.ErrUnixDayFieldConflict},
+	}
+
+	for _, t := range tt {
+		_, err := cronparse.FromUnixCron(t.crontab)
+		assert.ErrorIs(err, t.err, t.crontab)
+	}
+
+	_, err := cronparse.FromUnixCron("60 10 * * *")
+	var perr *cronparse.ParseError
+	assert.ErrorAs(err, &perr)
+	assert.EqualError(err, `"60 10 * * ? *": column 1 (Minutes): "60" is out of range (0-59); minutes must be 0-59`)
+
+	_, err = cronparse.FromUnixCron("0 10 * * 1-x/2")
+	assert.EqualError(err, `invalid crontab value: "x"`)
+
+	_, err = cronparse.FromUnixCron("0-30/0 10 * * *")
+	assert.EqualError(err, `invalid crontab step: "0"`)
+
+	for _, crontab := range []string{"0 22-2/2 * * *", "0 22-2 * * *", "0 0 1 NOV-FEB *", "0 9 * * 5-1", "0 9 * * 5-1/2", "0 9 * * FRI-MON"} {
+		_, err = cronparse.FromUnixCron(crontab)
+		assert.ErrorContains(err, "must not be reversed", crontab)
+	}
+
+	_, err = cronparse.FromUnixCron("0 22-2/2 * * *")
+	assert.EqualError(err, `invalid crontab range: "22-2" must not be reversed`)
+}
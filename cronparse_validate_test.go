@@ -0,0 +1,157 @@
+package cronparse_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/winebarrel/cronparse"
+)
+
+func TestValidateOK(t *testing.T) {
+	assert := assert.New(t)
+
+	tt := []string{
+		"0 10 * * ? *",
+		"0-59 0-23 1-31 1-12 ? 1970-2199",
+		"0/59 0/23 1/31 1/12 ? 1970/229",
+		"*/5 */2 */3 */4 ? */11",
+		"59 23 31 12 ? 2199",
+		"0 0 ? * 1-7 *",
+		"0 0 ? * 7#5 *",
+		"0 0 ? * 1#1 *",
+		"0 0 31W * ? *",
+		"0 0 1W * ? *",
+		"0 0 L * ? *",
+		"0 0 ? * L *",
+		"0 0 ? JAN-DEC MON-SUN *",
+	}
+
+	for _, exp := range tt {
+		_, err := cronparse.Parse(exp)
+		assert.NoError(err, exp)
+	}
+}
+
+func TestValidateError(t *testing.T) {
+	assert := assert.New(t)
+
+	tt := []struct {
+		exp      string
+		field    cronparse.Field
+		token    string
+		expected string
+	}{
+		{"60 * * * ? *", cronparse.FieldMinutes, "60", `Minutes: "60" is out of range (0-59)`},
+		{"0-60 * * * ? *", cronparse.FieldMinutes, "0-60", `Minutes: "0-60" is out of range (0-59)`},
+		{"60/5 * * * ? *", cronparse.FieldMinutes, "60/5", `Minutes: "60/5" is out of range (0-59)`},
+		{"*/0 * * * ? *", cronparse.FieldMinutes, "*/0", `Minutes: "*/0" must have a step of at least 1`},
+		{"* 24 * * ? *", cronparse.FieldHours, "24", `Hours: "24" is out of range (0-23)`},
+		{"* 1,2,25 * * ? *", cronparse.FieldHours, "25", `Hours: "25" is out of range (0-23)`},
+		{"* * 0 * ? *", cronparse.FieldDayOfMonth, "0", `DayOfMonth: "0" is out of range (1-31)`},
+		{"* * 32 * ? *", cronparse.FieldDayOfMonth, "32", `DayOfMonth: "32" is out of range (1-31)`},
+		{"* * 32W * ? *", cronparse.FieldDayOfMonth, "32W", `DayOfMonth: "32W" is out of range (1-31)`},
+		{"* * * 0 ? *", cronparse.FieldMonth, "0", `Month: "0" is out of range (1-12)`},
+		{"* * * 13 ? *", cronparse.FieldMonth, "13", `Month: "13" is out of range (1-12)`},
+		{"* * * 1/0 ? *", cronparse.FieldMonth, "1/0", `Month: "1/0" must have a step of at least 1`},
+		{"* * ? * 0 *", cronparse.FieldDayOfWeek, "0", `DayOfWeek: "0" is out of range (1-7)`},
+		{"* * ? * 3-8 *", cronparse.FieldDayOfWeek, "3-8", `DayOfWeek: "3-8" is out of range (1-7)`},
+		{"* * ? * 8L *", cronparse.FieldDayOfWeek, "8L", `DayOfWeek: "8L" is out of range (1-7)`},
+		{"* * ? * 8#1 *", cronparse.FieldDayOfWeek, "8#1", `DayOfWeek: "8#1" is out of range (1-7)`},
+		{"* * ? * 6#6 *", cronparse.FieldDayOfWeek, "6#6", `DayOfWeek: "6#6" is out of range (#1-#5)`},
+		{"* * ? * 6#0 *", cronparse.FieldDayOfWeek, "6#0", `DayOfWeek: "6#0" is out of range (#1-#5)`},
+		{"* * L-0 * ? *", cronparse.FieldDayOfMonth, "L-0", `DayOfMonth: "L-0" is out of range (L-1..L-30)`},
+		{"* * L-31 * ? *", cronparse.FieldDayOfMonth, "L-31", `DayOfMonth: "L-31" is out of range (L-1..L-30)`},
+		{"* * * * ? 1969", cronparse.FieldYear, "1969", `Year: "1969" is out of range (1970-2199)`},
+		{"* * * * ? 3000", cronparse.FieldYear, "3000", `Year: "3000" is out of range (1970-2199)`},
+		{"* * * * ? 2030-2020", cronparse.FieldYear, "2030-2020", `Year: "2030-2020" must not be a reversed range`},
+		{"0-60/5 * * * ? *", cronparse.FieldMinutes, "0-60/5", `Minutes: "0-60/5" is out of range (0-59)`},
+		{"0-30/0 * * * ? *", cronparse.FieldMinutes, "0-30/0", `Minutes: "0-30/0" must have a step of at least 1`},
+		{"* * * * ? 2030-2020/2", cronparse.FieldYear, "2030-2020/2", `Year: "2030-2020/2" must not be a reversed range`},
+		{"* * ? * MON-FRI/0 *", cronparse.FieldDayOfWeek, "MON-FRI/0", `DayOfWeek: "MON-FRI/0" must have a step of at least 1`},
+		{"99 25 40 13 ? 3000", cronparse.FieldMinutes, "99", `Minutes: "99" is out of range (0-59)`},
+	}
+
+	for _, t := range tt {
+		_, err := cronparse.Parse(t.exp)
+		var verr *cronparse.ValidationError
+
+		if assert.True(errors.As(err, &verr), t.exp) {
+			assert.Equal(t.field, verr.Field, t.exp)
+			assert.Equal(t.token, verr.Token, t.exp)
+			assert.Equal(t.expected, verr.Error(), t.exp)
+		}
+	}
+}
+
+func TestFieldString(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal("Minutes", cronparse.FieldMinutes.String())
+	assert.Equal("Hours", cronparse.FieldHours.String())
+	assert.Equal("DayOfMonth", cronparse.FieldDayOfMonth.String())
+	assert.Equal("Month", cronparse.FieldMonth.String())
+	assert.Equal("DayOfWeek", cronparse.FieldDayOfWeek.String())
+	assert.Equal("Year", cronparse.FieldYear.String())
+	assert.Equal("Seconds", cronparse.FieldSeconds.String())
+	assert.Equal("Field(7)", cronparse.Field(7).String())
+	assert.Equal("Field(-1)", cronparse.Field(-1).String())
+}
+
+func TestValidateDayFieldConflict(t *testing.T) {
+	assert := assert.New(t)
+
+	tt := []string{
+		"0 10 * * * *",
+		"0 10 1 * MON *",
+		"0 10 L * L *",
+		"0 10 ? * ? *",
+	}
+
+	for _, exp := range tt {
+		_, err := cronparse.Parse(exp)
+		assert.ErrorIs(err, cronparse.ErrDayFieldConflict, exp)
+	}
+}
+
+func TestValidateAnyWithOtherValues(t *testing.T) {
+	assert := assert.New(t)
+
+	tt := []struct {
+		exp      string
+		expected string
+	}{
+		{"0 10 ?,1 * MON *", `DayOfMonth: "?,1" must not combine '?' with other values`},
+		{"0 10 1 * MON,? *", `DayOfWeek: "MON,?" must not combine '?' with other values`},
+	}
+
+	for _, t := range tt {
+		_, err := cronparse.Parse(t.exp)
+		var verr *cronparse.ValidationError
+
+		if assert.True(errors.As(err, &verr), t.exp) {
+			assert.Equal(t.expected, verr.Error(), t.exp)
+		}
+	}
+}
+
+func TestMatchDayFieldConflict(t *testing.T) {
+	assert := assert.New(t)
+	tm := time.Date(2022, 10, 3, 10, 0, 0, 0, time.UTC)
+
+	tt := []struct {
+		exp      string
+		expected bool
+	}{
+		{"0 10 * * ? *", true},
+		{"0 10 ? * * *", true},
+		{"0 10 * * * *", false},
+		{"0 10 ? * ? *", false},
+	}
+
+	for _, t := range tt {
+		cron, err := cronparse.Parser.ParseString("", t.exp)
+		assert.NoError(err)
+		assert.Equal(t.expected, cron.Match(tm), t.exp)
+	}
+}
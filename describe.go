@@ -0,0 +1,378 @@
+package cronparse
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/winebarrel/cronparse/utils"
+)
+
+// Describe returns an English description of the expression,
+// e.g. "Every 15 minutes, between 09:00 and 17:59, Monday through Friday".
+func (v *Expression) Describe() string {
+	return v.describe(localeEnglish)
+}
+
+// DescribeLocale returns a description of the expression in the language registered by RegisterLocale.
+// "en" and "ja" are available by default; a region such as "ja-JP" falls back to its language.
+func (v *Expression) DescribeLocale(lang string) (string, error) {
+	l, ok := lookupLocale(lang)
+
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrUnknownLocale, lang)
+	}
+
+	return v.describe(l), nil
+}
+
+func (v *Expression) describe(l *Locale) string {
+	var times []string
+
+	if v.Seconds != nil && v.Seconds.String() != "0" {
+		times = []string{l.join(v.Seconds.describe(l))}
+
+		if v.Minutes.String() != "*" {
+			times = append(times, l.join(v.Minutes.describe(l)))
+		}
+
+		if p := v.Hours.describe(l); len(p) > 0 {
+			times = append(times, l.join(p))
+		}
+	} else if clocks, ok := v.clockTimes(l); ok {
+		times = []string{l.sprintf(MsgAt, l.join(clocks))}
+	} else {
+		times = []string{l.join(v.Minutes.describe(l))}
+
+		if p := v.Hours.describe(l); len(p) > 0 {
+			times = append(times, l.join(p))
+		}
+	}
+
+	days := []string{}
+
+	for _, p := range [][]string{
+		v.DayOfMonth.describe(l),
+		v.DayOfWeek.describe(l),
+		v.Month.describe(l),
+		v.Year.describe(l),
+	} {
+		if len(p) > 0 {
+			days = append(days, l.join(p))
+		}
+	}
+
+	phrases := append(times, days...)
+
+	if l.LargestFirst {
+		for i, j := 0, len(phrases)-1; i < j; i, j = i+1, j-1 {
+			phrases[i], phrases[j] = phrases[j], phrases[i]
+		}
+	}
+
+	s := ""
+
+	for i, p := range phrases {
+		if i > 0 {
+			s += l.separator()
+		}
+
+		s += p
+	}
+
+	if l.Capitalize {
+		s = capitalize(s)
+	}
+
+	return s
+}
+
+// clockTimes returns the time of every trigger of the day when both minutes and hours are plain numbers.
+func (v *Expression) clockTimes(l *Locale) ([]string, bool) {
+	minutes := []int{}
+	hours := []int{}
+
+	for _, e := range v.Minutes.Exps {
+		if e.Number == nil {
+			return nil, false
+		}
+
+		minutes = append(minutes, e.Number.Value)
+	}
+
+	for _, e := range v.Hours.Exps {
+		if e.Number == nil {
+			return nil, false
+		}
+
+		hours = append(hours, e.Number.Value)
+	}
+
+	sort.Ints(minutes)
+	sort.Ints(hours)
+	times := []string{}
+
+	for _, h := range hours {
+		for _, m := range minutes {
+			times = append(times, l.clock(h, m))
+		}
+	}
+
+	return times, true
+}
+
+// seconds
+func (v *Seconds) describe(l *Locale) []string {
+	phrases := []string{}
+	numbers := []string{}
+
+	for _, e := range v.Exps {
+		if e.Number != nil {
+			numbers = append(numbers, e.Number.String())
+		} else if r := e.NumberRangeIncrement; r != nil {
+			phrases = append(phrases, l.sprintf(MsgSecondStepRange, l.every(r.Buttom, MsgEverySecond, MsgEveryNSeconds), r.From, r.To))
+		} else if e.Increment != nil {
+			s := l.every(e.Increment.Buttom, MsgEverySecond, MsgEveryNSeconds)
+
+			if !e.Increment.Wildcard && e.Increment.Top > 0 {
+				s = l.sprintf(MsgStartingAtSecond, s, e.Increment.Top)
+			}
+
+			phrases = append(phrases, s)
+		} else if e.NumberRange != nil {
+			phrases = append(phrases, l.sprintf(MsgSecondRange, e.NumberRange.From, e.NumberRange.To))
+		} else if e.All != nil {
+			phrases = append(phrases, l.sprintf(MsgEverySecond))
+		}
+	}
+
+	if len(numbers) == 1 {
+		phrases = append([]string{l.sprintf(MsgAtSecond, numbers[0])}, phrases...)
+	} else if len(numbers) > 1 {
+		phrases = append([]string{l.sprintf(MsgAtSeconds, l.join(numbers))}, phrases...)
+	}
+
+	return phrases
+}
+
+// minutes
+func (v *Minutes) describe(l *Locale) []string {
+	phrases := []string{}
+	numbers := []string{}
+
+	for _, e := range v.Exps {
+		if e.Number != nil {
+			numbers = append(numbers, e.Number.String())
+		} else if r := e.NumberRangeIncrement; r != nil {
+			phrases = append(phrases, l.sprintf(MsgMinuteStepRange, l.every(r.Buttom, MsgEveryMinute, MsgEveryNMinutes), r.From, r.To))
+		} else if e.Increment != nil {
+			s := l.every(e.Increment.Buttom, MsgEveryMinute, MsgEveryNMinutes)
+
+			if !e.Increment.Wildcard && e.Increment.Top > 0 {
+				s = l.sprintf(MsgStartingAtMinute, s, e.Increment.Top)
+			}
+
+			phrases = append(phrases, s)
+		} else if e.NumberRange != nil {
+			phrases = append(phrases, l.sprintf(MsgMinuteRange, e.NumberRange.From, e.NumberRange.To))
+		} else if e.All != nil {
+			phrases = append(phrases, l.sprintf(MsgEveryMinute))
+		}
+	}
+
+	if len(numbers) == 1 {
+		phrases = append([]string{l.sprintf(MsgAtMinute, numbers[0])}, phrases...)
+	} else if len(numbers) > 1 {
+		phrases = append([]string{l.sprintf(MsgAtMinutes, l.join(numbers))}, phrases...)
+	}
+
+	return phrases
+}
+
+// hours
+func (v *Hours) describe(l *Locale) []string {
+	phrases := []string{}
+
+	for _, e := range v.Exps {
+		if e.Number != nil {
+			phrases = append(phrases, l.sprintf(MsgHourRange, l.clock(e.Number.Value, 0), l.clock(e.Number.Value, 59)))
+		} else if r := e.NumberRangeIncrement; r != nil {
+			phrases = append(phrases, l.sprintf(MsgHourStepRange, l.every(r.Buttom, MsgEveryHour, MsgEveryNHours), l.clock(r.From, 0), l.clock(r.To, 59)))
+		} else if e.Increment != nil {
+			s := l.every(e.Increment.Buttom, MsgEveryHour, MsgEveryNHours)
+
+			if !e.Increment.Wildcard && e.Increment.Top > 0 {
+				s = l.sprintf(MsgStartingAtHour, s, l.clock(e.Increment.Top, 0))
+			}
+
+			phrases = append(phrases, s)
+		} else if e.NumberRange != nil {
+			phrases = append(phrases, l.sprintf(MsgHourRange, l.clock(e.NumberRange.From, 0), l.clock(e.NumberRange.To, 59)))
+		}
+	}
+
+	return phrases
+}
+
+// day of month
+func (v *DayOfMonth) describe(l *Locale) []string {
+	phrases := []string{}
+	numbers := []string{}
+
+	for _, e := range v.Exps {
+		if e.Number != nil {
+			numbers = append(numbers, e.Number.String())
+		} else if r := e.NumberRangeIncrement; r != nil {
+			phrases = append(phrases, l.sprintf(MsgDayStepRange, l.every(r.Buttom, MsgEveryDay, MsgEveryNDays), r.From, r.To))
+		} else if e.Increment != nil {
+			s := l.every(e.Increment.Buttom, MsgEveryDay, MsgEveryNDays)
+
+			if !e.Increment.Wildcard && e.Increment.Top > 1 {
+				s = l.sprintf(MsgStartingOnDay, s, e.Increment.Top)
+			}
+
+			phrases = append(phrases, s)
+		} else if e.NumberRange != nil {
+			phrases = append(phrases, l.sprintf(MsgDayRange, e.NumberRange.From, e.NumberRange.To))
+		} else if e.Weekday != nil {
+			phrases = append(phrases, l.sprintf(MsgNearestWeekday, e.Weekday.Value))
+		} else if e.LastWeekday != nil {
+			phrases = append(phrases, l.sprintf(MsgLastWeekdayOfMonth))
+		} else if e.LastOffset != nil {
+			phrases = append(phrases, l.every(e.LastOffset.Offset, MsgDayBeforeLastOfMonth, MsgDaysBeforeLastOfMonth))
+		} else if e.Last != nil {
+			phrases = append(phrases, l.sprintf(MsgLastDayOfMonth))
+		}
+	}
+
+	if len(numbers) == 1 {
+		phrases = append([]string{l.sprintf(MsgOnDay, numbers[0])}, phrases...)
+	} else if len(numbers) > 1 {
+		phrases = append([]string{l.sprintf(MsgOnDays, l.join(numbers))}, phrases...)
+	}
+
+	return phrases
+}
+
+// month
+func (v *Month) describe(l *Locale) []string {
+	phrases := []string{}
+	names := []string{}
+
+	for _, e := range v.Exps {
+		if e.Number != nil {
+			names = append(names, l.month(time.Month(e.Number.Value)))
+		} else if e.Name != nil {
+			names = append(names, l.month(time.Month(utils.MonthNameToNumber(e.Name.Value))))
+		} else if r := e.NumberRangeIncrement; r != nil {
+			phrases = append(phrases, l.sprintf(MsgMonthStepRange, l.every(r.Buttom, MsgEveryMonth, MsgEveryNMonths), l.month(time.Month(r.From)), l.month(time.Month(r.To))))
+		} else if r := e.NameRangeIncrement; r != nil {
+			from := time.Month(utils.MonthNameToNumber(r.From))
+			to := time.Month(utils.MonthNameToNumber(r.To))
+			phrases = append(phrases, l.sprintf(MsgMonthStepRange, l.every(r.Buttom, MsgEveryMonth, MsgEveryNMonths), l.month(from), l.month(to)))
+		} else if e.Increment != nil {
+			s := l.every(e.Increment.Buttom, MsgEveryMonth, MsgEveryNMonths)
+
+			if !e.Increment.Wildcard && e.Increment.Top > 1 {
+				s = l.sprintf(MsgStartingInMonth, s, l.month(time.Month(e.Increment.Top)))
+			}
+
+			phrases = append(phrases, s)
+		} else if e.NumberRange != nil {
+			phrases = append(phrases, l.sprintf(MsgMonthRange, l.month(time.Month(e.NumberRange.From)), l.month(time.Month(e.NumberRange.To))))
+		} else if e.NameRange != nil {
+			from := time.Month(utils.MonthNameToNumber(e.NameRange.From))
+			to := time.Month(utils.MonthNameToNumber(e.NameRange.To))
+			phrases = append(phrases, l.sprintf(MsgMonthRange, l.month(from), l.month(to)))
+		}
+	}
+
+	if len(names) > 0 {
+		phrases = append([]string{l.sprintf(MsgInMonths, l.join(names))}, phrases...)
+	}
+
+	return phrases
+}
+
+// day of week
+func (v *DayOfWeek) describe(l *Locale) []string {
+	phrases := []string{}
+	names := []string{}
+
+	for _, e := range v.Exps {
+		if e.Number != nil {
+			names = append(names, l.weekday(awsWeekday(e.Number.Value)))
+		} else if e.Name != nil {
+			names = append(names, l.weekday(weekNameToWeekday(e.Name.Value)))
+		} else if r := e.NumberRangeIncrement; r != nil {
+			phrases = append(phrases, l.sprintf(MsgWeekdayStepRange, l.every(r.Buttom, MsgEveryDayOfWeek, MsgEveryNDaysOfWeek), l.weekday(awsWeekday(r.From)), l.weekday(awsWeekday(r.To))))
+		} else if r := e.NameRangeIncrement; r != nil {
+			from := weekNameToWeekday(r.From)
+			to := weekNameToWeekday(r.To)
+			phrases = append(phrases, l.sprintf(MsgWeekdayStepRange, l.every(r.Buttom, MsgEveryDayOfWeek, MsgEveryNDaysOfWeek), l.weekday(from), l.weekday(to)))
+		} else if e.Increment != nil {
+			s := l.every(e.Increment.Buttom, MsgEveryDayOfWeek, MsgEveryNDaysOfWeek)
+
+			if !e.Increment.Wildcard && e.Increment.Top > 1 {
+				s = l.sprintf(MsgStartingOnWeekday, s, l.weekday(awsWeekday(e.Increment.Top)))
+			}
+
+			phrases = append(phrases, s)
+		} else if e.NumberRange != nil {
+			phrases = append(phrases, l.sprintf(MsgWeekdayRange, l.weekday(awsWeekday(e.NumberRange.From)), l.weekday(awsWeekday(e.NumberRange.To))))
+		} else if e.NameRange != nil {
+			phrases = append(phrases, l.sprintf(MsgWeekdayRange, l.weekday(weekNameToWeekday(e.NameRange.From)), l.weekday(weekNameToWeekday(e.NameRange.To))))
+		} else if e.LastInstance != nil {
+			phrases = append(phrases, l.sprintf(MsgLastInstanceOfWeekday, l.weekday(e.LastInstance.weekday())))
+		} else if e.Instance != nil {
+			phrases = append(phrases, l.sprintf(MsgNthWeekday, l.ordinal(e.Instance.NthDayOfWeek), l.weekday(awsWeekday(e.Instance.DayOfWeek))))
+		} else if e.Last != nil {
+			phrases = append(phrases, l.sprintf(MsgLastDayOfWeek))
+		}
+	}
+
+	if len(names) > 0 {
+		phrases = append([]string{l.sprintf(MsgOnWeekdays, l.join(names))}, phrases...)
+	}
+
+	return phrases
+}
+
+// year
+func (v *Year) describe(l *Locale) []string {
+	phrases := []string{}
+	numbers := []string{}
+
+	for _, e := range v.Exps {
+		if e.Number != nil {
+			numbers = append(numbers, e.Number.String())
+		} else if r := e.NumberRangeIncrement; r != nil {
+			phrases = append(phrases, l.sprintf(MsgYearStepRange, l.every(r.Buttom, MsgEveryYear, MsgEveryNYears), r.From, r.To))
+		} else if e.Increment != nil {
+			s := l.every(e.Increment.Buttom, MsgEveryYear, MsgEveryNYears)
+
+			if !e.Increment.Wildcard && e.Increment.Top > minYear {
+				s = l.sprintf(MsgStartingInYear, s, e.Increment.Top)
+			}
+
+			phrases = append(phrases, s)
+		} else if e.NumberRange != nil {
+			phrases = append(phrases, l.sprintf(MsgYearRange, e.NumberRange.From, e.NumberRange.To))
+		}
+	}
+
+	if len(numbers) > 0 {
+		phrases = append([]string{l.sprintf(MsgInYears, l.join(numbers))}, phrases...)
+	}
+
+	return phrases
+}
+
+// awsWeekday converts the day-of-week number of Amazon EventBridge (1=SUN) to time.Weekday.
+func awsWeekday(n int) time.Weekday {
+	return time.Weekday((n - 1) % 7)
+}
+
+func weekNameToWeekday(s string) time.Weekday {
+	return time.Weekday(utils.WeekNameToNumber(s) % 7)
+}
@@ -0,0 +1,244 @@
+package cronparse
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/alecthomas/participle/v2"
+)
+
+// parse error
+type ParseError struct {
+	Offset int
+	// Index is the position of the field in the expression, counting from 0 in the order of its dialect:
+	// minutes are 0 in EventBridge but 1 in Quartz. It may be past the last field.
+	Index int
+	Field Field
+	Token string
+	Hint  string
+	Err   error
+	msg   string
+}
+
+func (e *ParseError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "column %d", e.Offset+1)
+
+	if e.Field.valid() {
+		fmt.Fprintf(&b, " (%s)", e.Field)
+	}
+
+	fmt.Fprintf(&b, ": %s", e.msg)
+
+	if e.Hint != "" {
+		fmt.Fprintf(&b, "; %s", e.Hint)
+	}
+
+	return b.String()
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// dialect is the order of the fields in an expression.
+type dialect struct {
+	fields []Field
+	hint   string
+	hints  map[Field]string // overrides Field.hint
+}
+
+var (
+	eventBridgeDialect = &dialect{
+		fields: []Field{FieldMinutes, FieldHours, FieldDayOfMonth, FieldMonth, FieldDayOfWeek, FieldYear},
+		hint:   "an expression has 6 fields: minutes hours day-of-month month day-of-week year",
+	}
+	quartzDialect = &dialect{
+		fields: []Field{FieldSeconds, FieldMinutes, FieldHours, FieldDayOfMonth, FieldMonth, FieldDayOfWeek, FieldYear},
+		hint:   "a Quartz expression has 6 or 7 fields: seconds minutes hours day-of-month month day-of-week [year]",
+		hints:  map[Field]string{FieldYear: "year must be 1970-2099"},
+	}
+)
+
+// field returns the field at the position, or an invalid field past the last one.
+func (d *dialect) field(pos int) Field {
+	if 0 <= pos && pos < len(d.fields) {
+		return d.fields[pos]
+	}
+
+	return Field(-1)
+}
+
+func (d *dialect) fieldHint(f Field) string {
+	if h, ok := d.hints[f]; ok {
+		return h
+	}
+
+	return f.hint()
+}
+
+// index returns the position of the field, or the number of fields if the dialect does not have it.
+func (d *dialect) index(f Field) int {
+	for pos, g := range d.fields {
+		if g == f {
+			return pos
+		}
+	}
+
+	return len(d.fields)
+}
+
+// endIndex returns the position of the field at the end of the expression, which is the last field at most.
+func (d *dialect) endIndex(exp string) int {
+	if pos := fieldIndex(exp, len(exp)); pos < len(d.fields) {
+		return pos
+	}
+
+	return len(d.fields) - 1
+}
+
+// offset returns the byte offset at which the field starts.
+func (d *dialect) offset(exp string, f Field) int {
+	if pos := d.index(f); pos < len(d.fields) {
+		return fieldOffset(exp, pos)
+	}
+
+	return len(exp)
+}
+
+func newParseError(d *dialect, exp string, err error) error {
+	var verr *ValidationError
+	var uerr *participle.UnexpectedTokenError
+	var perr participle.Error
+
+	e := &ParseError{Err: err}
+
+	if errors.As(err, &verr) {
+		e.Field = verr.Field
+		e.Index = d.index(verr.Field)
+		e.Token = verr.Token
+		e.Offset = d.offset(exp, verr.Field)
+
+		if i := strings.Index(exp[e.Offset:], verr.Token); i >= 0 {
+			e.Offset += i
+		}
+
+		e.msg = fmt.Sprintf("%q %s", verr.Token, verr.Message)
+		e.Hint = d.fieldHint(verr.Field)
+	} else if errors.Is(err, ErrDayFieldConflict) {
+		e.Field = FieldDayOfMonth
+		e.Index = d.index(FieldDayOfMonth)
+		e.Offset = d.offset(exp, FieldDayOfMonth)
+		e.Token = tokenAt(exp, e.Offset)
+		e.msg = err.Error()
+		e.Hint = "use ? in one of the day fields"
+	} else if errors.As(err, &perr) && strings.TrimLeftFunc(exp, unicode.IsSpace) != exp {
+		e.Field = d.field(0)
+		e.msg = "unexpected leading whitespace"
+		e.Hint = d.hint
+	} else if errors.As(err, &uerr) && uerr.Unexpected.EOF() {
+		e.Offset = len(exp)
+		e.Index = d.endIndex(exp)
+		e.Field = d.field(e.Index)
+		e.msg = "unexpected end of expression"
+		e.Hint = d.hint
+	} else if errors.As(err, &perr) {
+		e.Offset = perr.Position().Offset
+		e.Token = tokenAt(exp, e.Offset)
+
+		if e.Token == "" {
+			// The lexer stops at the whitespace separating the fields, so point at the next field instead.
+			next := strings.IndexFunc(exp[e.Offset:], func(r rune) bool { return !unicode.IsSpace(r) })
+
+			if next < 0 {
+				e.Index = fieldIndex(exp, e.Offset)
+				e.Field = d.field(e.Index)
+				e.msg = "unexpected trailing whitespace"
+				return e
+			}
+
+			e.Offset += next
+			e.Token = tokenAt(exp, e.Offset)
+		}
+
+		e.Index = fieldIndex(exp, e.Offset)
+		e.Field = d.field(e.Index)
+		e.msg = fmt.Sprintf("unexpected token %q", e.Token)
+
+		if e.Field.valid() {
+			e.Hint = d.fieldHint(e.Field)
+		} else {
+			e.Hint = d.hint
+		}
+	} else {
+		return err
+	}
+
+	return e
+}
+
+func (f Field) valid() bool {
+	return 0 <= f && int(f) < len(fieldNames)
+}
+
+func (f Field) hint() string {
+	switch f {
+	case FieldMinutes:
+		return "minutes must be 0-59"
+	case FieldHours:
+		return "hours must be 0-23"
+	case FieldDayOfMonth:
+		return "day-of-month must be 1-31, L, L-n, LW or nW"
+	case FieldMonth:
+		return "month must be 1-12; month names are JAN..DEC"
+	case FieldDayOfWeek:
+		return "day-of-week must be 1-7 (1 is SUN), L, nL or n#m; day-of-week names are SUN..SAT"
+	case FieldYear:
+		return "year must be 1970-2199"
+	case FieldSeconds:
+		return "seconds must be 0-59"
+	}
+
+	return ""
+}
+
+// fieldIndex returns the position of the field that contains the byte offset.
+func fieldIndex(exp string, offset int) int {
+	idx := len(strings.Fields(exp[:offset]))
+
+	if 0 < offset && offset < len(exp) && !unicode.IsSpace(rune(exp[offset-1])) {
+		idx--
+	}
+
+	return idx
+}
+
+// fieldOffset returns the byte offset at which the field at the position starts.
+func fieldOffset(exp string, pos int) int {
+	idx := -1
+
+	for i := 0; i < len(exp); i++ {
+		if !unicode.IsSpace(rune(exp[i])) && (i == 0 || unicode.IsSpace(rune(exp[i-1]))) {
+			idx++
+
+			if idx == pos {
+				return i
+			}
+		}
+	}
+
+	return len(exp)
+}
+
+// tokenAt returns the non-whitespace run starting at the byte offset.
+func tokenAt(exp string, offset int) string {
+	s := exp[offset:]
+
+	if end := strings.IndexFunc(s, unicode.IsSpace); end >= 0 {
+		return s[:end]
+	}
+
+	return s
+}
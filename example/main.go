@@ -29,4 +29,12 @@ func main() {
 	//=> 2022-11-04 10:00:00 +0000 UTC
 	fmt.Println(cron.NextN(time.Date(2022, 11, 3, 10, 0, 0, 0, time.UTC), 3))
 	//=> [2022-11-03 10:00:00 +0000 UTC 2022-11-04 10:00:00 +0000 UTC 2022-11-05 10:00:00 +0000 UTC]
+
+	fmt.Println(cron.Prev(time.Date(2022, 11, 3, 9, 0, 0, 0, time.UTC)))
+	//=> 2022-11-02 10:00:00 +0000 UTC
+	fmt.Println(cron.PrevN(time.Date(2022, 11, 3, 10, 0, 0, 0, time.UTC), 3))
+	//=> [2022-11-03 10:00:00 +0000 UTC 2022-11-02 10:00:00 +0000 UTC 2022-11-01 10:00:00 +0000 UTC]
+
+	fmt.Println(cron.Describe())
+	//=> At 10:00
 }
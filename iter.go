@@ -0,0 +1,162 @@
+package cronparse
+
+import (
+	"time"
+)
+
+// Iterator walks the trigger times of an expression in ascending order.
+// The candidate values of each field are computed once when the iterator is created.
+type Iterator struct {
+	years    []int
+	months   []time.Month
+	hours    []int
+	minutes  []int
+	seconds  []int
+	dayMatch func(time.Time) bool
+	loc      *time.Location
+	// from is set when the iterator follows the daylight saving time rules of EventBridge Scheduler
+	from *time.Time
+	// wall clock position to resume the search from
+	year   int
+	month  time.Month
+	day    int
+	hour   int
+	minute int
+	second int
+}
+
+func (v *Expression) Iter(from time.Time) *Iterator {
+	it := &Iterator{
+		loc:    from.Location(),
+		year:   from.Year(),
+		month:  from.Month(),
+		day:    from.Day(),
+		hour:   from.Hour(),
+		minute: from.Minute(),
+	}
+
+	if v.Seconds != nil {
+		it.second = from.Second()
+	}
+
+	if v.hasDayFieldConflict() {
+		return it
+	}
+
+	it.years = v.candidateYears(from)
+	it.months = v.candidateMonths(from)
+	it.hours = v.candidateHours(from)
+	it.minutes = v.candidateMinutes(from)
+	it.seconds = v.candidateSeconds(from)
+	it.dayMatch = v.DayOfMonth.Match
+
+	if v.DayOfMonth.HasAny() {
+		it.dayMatch = v.DayOfWeek.Match
+	}
+
+	return it
+}
+
+// IterIn is like Iter but evaluates the expression in loc as Amazon EventBridge Scheduler does.
+// A trigger in a skipped hour (spring forward) is skipped, and a trigger in a repeated hour (fall back)
+// runs only once, at its first occurrence.
+func (v *Expression) IterIn(from time.Time, loc *time.Location) *Iterator {
+	from = from.In(loc)
+	it := v.Iter(from)
+	start := from.Truncate(time.Minute)
+
+	if v.Seconds != nil {
+		start = from.Truncate(time.Second)
+	}
+
+	it.from = &start
+
+	return it
+}
+
+// Next returns the next trigger time, or false when there are no more triggers.
+func (it *Iterator) Next() (time.Time, bool) {
+	for _, year := range it.years {
+		if year < it.year {
+			continue
+		}
+
+		for _, month := range it.months {
+			if year == it.year && month < it.month {
+				continue
+			}
+
+			for day := 1; day <= 31; day++ {
+				if year == it.year && month == it.month && day < it.day {
+					continue
+				}
+
+				// Use noon because midnight does not exist on some days in some time zones
+				dayOfMonth := time.Date(year, month, day, 12, 0, 0, 0, it.loc)
+
+				if dayOfMonth.Month() != month {
+					break
+				}
+
+				if !it.dayMatch(dayOfMonth) {
+					continue
+				}
+
+				for _, hour := range it.hours {
+					if year == it.year && month == it.month && day == it.day && hour < it.hour {
+						continue
+					}
+
+					for _, minute := range it.minutes {
+						if year == it.year && month == it.month && day == it.day && hour == it.hour && minute < it.minute {
+							continue
+						}
+
+						for _, second := range it.seconds {
+							if year == it.year && month == it.month && day == it.day && hour == it.hour && minute == it.minute && second < it.second {
+								continue
+							}
+
+							// The second may overflow to 60; it only has to compare greater than every candidate.
+							it.year, it.month, it.day, it.hour, it.minute, it.second = year, month, day, hour, minute, second+1
+							t := time.Date(year, month, day, hour, minute, second, 0, it.loc)
+
+							if it.from != nil {
+								t = firstOccurrence(t)
+							}
+
+							if it.from != nil && (t.Hour() != hour || t.Minute() != minute || t.Before(*it.from)) {
+								continue
+							}
+
+							return t, true
+						}
+					}
+				}
+			}
+		}
+	}
+
+	it.years = nil
+
+	return time.Time{}, false
+}
+
+// firstOccurrence returns the first of the two instants of a wall clock time repeated when the clocks fall back.
+// time.Date does not specify which one it returns, and returns the later one in zones east of UTC.
+func firstOccurrence(t time.Time) time.Time {
+	_, offset := t.Zone()
+	_, before := t.Add(-24 * time.Hour).Zone()
+
+	if before <= offset {
+		return t
+	}
+
+	e := t.Add(-time.Duration(before-offset) * time.Second)
+
+	if e.Day() == t.Day() && e.Hour() == t.Hour() && e.Minute() == t.Minute() && e.Second() == t.Second() {
+		return e
+	}
+
+	return t
+}
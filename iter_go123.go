@@ -0,0 +1,21 @@
+//go:build go1.23
+
+package cronparse
+
+import (
+	"iter"
+	"time"
+)
+
+// Seq returns the trigger times at or after from as an iter.Seq.
+func (v *Expression) Seq(from time.Time) iter.Seq[time.Time] {
+	return func(yield func(time.Time) bool) {
+		it := v.Iter(from)
+
+		for t, ok := it.Next(); ok; t, ok = it.Next() {
+			if !yield(t) {
+				return
+			}
+		}
+	}
+}
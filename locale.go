@@ -0,0 +1,343 @@
+package cronparse
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+var (
+	ErrUnknownLocale = errors.New("unknown locale")
+)
+
+// Message identifies a phrase of a description.
+// The comment of each message shows its arguments.
+type Message int
+
+const (
+	MsgClock                 Message = iota // hour, minute
+	MsgAt                                   // list of clock times
+	MsgEveryMinute                          // -
+	MsgEveryNMinutes                        // step
+	MsgStartingAtMinute                     // "every" phrase, minute
+	MsgMinuteRange                          // from, to
+	MsgAtMinute                             // minute
+	MsgAtMinutes                            // list of minutes
+	MsgHourRange                            // from clock, to clock
+	MsgEveryHour                            // -
+	MsgEveryNHours                          // step
+	MsgStartingAtHour                       // "every" phrase, clock
+	MsgEveryDay                             // -
+	MsgEveryNDays                           // step
+	MsgStartingOnDay                        // "every" phrase, day
+	MsgDayRange                             // from, to
+	MsgNearestWeekday                       // day
+	MsgLastDayOfMonth                       // -
+	MsgOnDay                                // day
+	MsgOnDays                               // list of days
+	MsgEveryMonth                           // -
+	MsgEveryNMonths                         // step
+	MsgStartingInMonth                      // "every" phrase, month
+	MsgMonthRange                           // from month, to month
+	MsgInMonths                             // list of months
+	MsgEveryDayOfWeek                       // -
+	MsgEveryNDaysOfWeek                     // step
+	MsgStartingOnWeekday                    // "every" phrase, weekday
+	MsgWeekdayRange                         // from weekday, to weekday
+	MsgOnWeekdays                           // list of weekdays
+	MsgNthWeekday                           // ordinal, weekday
+	MsgLastDayOfWeek                        // -
+	MsgEveryYear                            // -
+	MsgEveryNYears                          // step
+	MsgStartingInYear                       // "every" phrase, year
+	MsgYearRange                            // from, to
+	MsgInYears                              // list of years
+	MsgEverySecond                          // -
+	MsgEveryNSeconds                        // step
+	MsgStartingAtSecond                     // "every" phrase, second
+	MsgSecondRange                          // from, to
+	MsgAtSecond                             // second
+	MsgAtSeconds                            // list of seconds
+	MsgSecondStepRange                      // "every" phrase, from, to
+	MsgMinuteStepRange                      // "every" phrase, from, to
+	MsgHourStepRange                        // "every" phrase, from clock, to clock
+	MsgDayStepRange                         // "every" phrase, from, to
+	MsgMonthStepRange                       // "every" phrase, from month, to month
+	MsgWeekdayStepRange                     // "every" phrase, from weekday, to weekday
+	MsgYearStepRange                        // "every" phrase, from, to
+	MsgLastWeekdayOfMonth                   // -
+	MsgDayBeforeLastOfMonth                 // -
+	MsgDaysBeforeLastOfMonth                // days
+	MsgLastInstanceOfWeekday                // weekday
+)
+
+// Locale is a message catalogue for DescribeLocale.
+// Messages are fmt formats; a missing message or an empty field falls back to English.
+type Locale struct {
+	Messages     map[Message]string
+	Weekdays     [7]string  // indexed by time.Weekday
+	Months       [12]string // January first
+	Ordinal      func(n int) string
+	Join         func(items []string) string
+	Separator    string
+	Capitalize   bool
+	LargestFirst bool // describe years first and minutes last
+}
+
+var localeEnglish = &Locale{
+	Messages: map[Message]string{
+		MsgClock:                 "%02d:%02d",
+		MsgAt:                    "at %s",
+		MsgEveryMinute:           "every minute",
+		MsgEveryNMinutes:         "every %d minutes",
+		MsgStartingAtMinute:      "%s starting at minute %d",
+		MsgMinuteRange:           "every minute from %d through %d past the hour",
+		MsgAtMinute:              "at minute %s past the hour",
+		MsgAtMinutes:             "at minutes %s past the hour",
+		MsgHourRange:             "between %s and %s",
+		MsgEveryHour:             "every hour",
+		MsgEveryNHours:           "every %d hours",
+		MsgStartingAtHour:        "%s starting at %s",
+		MsgEveryDay:              "every day",
+		MsgEveryNDays:            "every %d days",
+		MsgStartingOnDay:         "%s starting on day %d of the month",
+		MsgDayRange:              "between day %d and %d of the month",
+		MsgNearestWeekday:        "on the weekday nearest day %d of the month",
+		MsgLastDayOfMonth:        "on the last day of the month",
+		MsgOnDay:                 "on day %s of the month",
+		MsgOnDays:                "on days %s of the month",
+		MsgEveryMonth:            "every month",
+		MsgEveryNMonths:          "every %d months",
+		MsgStartingInMonth:       "%s starting in %s",
+		MsgMonthRange:            "%s through %s",
+		MsgInMonths:              "only in %s",
+		MsgEveryDayOfWeek:        "every day of the week",
+		MsgEveryNDaysOfWeek:      "every %d days of the week",
+		MsgStartingOnWeekday:     "%s starting on %s",
+		MsgWeekdayRange:          "%s through %s",
+		MsgOnWeekdays:            "only on %s",
+		MsgNthWeekday:            "on the %s %s of the month",
+		MsgLastDayOfWeek:         "on the last day of the week",
+		MsgEveryYear:             "every year",
+		MsgEveryNYears:           "every %d years",
+		MsgStartingInYear:        "%s starting in %d",
+		MsgYearRange:             "%d through %d",
+		MsgInYears:               "only in %s",
+		MsgEverySecond:           "every second",
+		MsgEveryNSeconds:         "every %d seconds",
+		MsgStartingAtSecond:      "%s starting at second %d",
+		MsgSecondRange:           "every second from %d through %d past the minute",
+		MsgAtSecond:              "at second %s past the minute",
+		MsgAtSeconds:             "at seconds %s past the minute",
+		MsgSecondStepRange:       "%s from %d through %d past the minute",
+		MsgMinuteStepRange:       "%s from %d through %d past the hour",
+		MsgHourStepRange:         "%s between %s and %s",
+		MsgDayStepRange:          "%s between day %d and %d of the month",
+		MsgMonthStepRange:        "%s from %s through %s",
+		MsgWeekdayStepRange:      "%s from %s through %s",
+		MsgYearStepRange:         "%s from %d through %d",
+		MsgLastWeekdayOfMonth:    "on the last weekday of the month",
+		MsgDayBeforeLastOfMonth:  "on the day before the last day of the month",
+		MsgDaysBeforeLastOfMonth: "on the day %d days before the last day of the month",
+		MsgLastInstanceOfWeekday: "on the last %s of the month",
+	},
+	Weekdays: [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+	Months:   [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	Ordinal: func(n int) string {
+		ordinals := []string{"first", "second", "third", "fourth", "fifth"}
+
+		if 1 <= n && n <= len(ordinals) {
+			return ordinals[n-1]
+		}
+
+		return fmt.Sprintf("#%d", n)
+	},
+	Join: func(items []string) string {
+		return joinList(items, ", ", " and ")
+	},
+	Separator:  ", ",
+	Capitalize: true,
+}
+
+var localeJapanese = &Locale{
+	Messages: map[Message]string{
+		MsgClock:                 "%02d:%02d",
+		MsgAt:                    "%sに",
+		MsgEveryMinute:           "毎分",
+		MsgEveryNMinutes:         "%d分ごと",
+		MsgStartingAtMinute:      "%[2]d分から%[1]s",
+		MsgMinuteRange:           "毎時%d分から%d分まで毎分",
+		MsgAtMinute:              "毎時%s分",
+		MsgAtMinutes:             "毎時%s分",
+		MsgHourRange:             "%sから%sの間",
+		MsgEveryHour:             "毎時",
+		MsgEveryNHours:           "%d時間ごと",
+		MsgStartingAtHour:        "%[2]sから%[1]s",
+		MsgEveryDay:              "毎日",
+		MsgEveryNDays:            "%d日ごと",
+		MsgStartingOnDay:         "%[2]d日から%[1]s",
+		MsgDayRange:              "毎月%d日から%d日まで",
+		MsgNearestWeekday:        "毎月%d日に最も近い平日",
+		MsgLastDayOfMonth:        "毎月末日",
+		MsgOnDay:                 "毎月%s日",
+		MsgOnDays:                "毎月%s日",
+		MsgEveryMonth:            "毎月",
+		MsgEveryNMonths:          "%dか月ごと",
+		MsgStartingInMonth:       "%[2]sから%[1]s",
+		MsgMonthRange:            "%sから%sまで",
+		MsgInMonths:              "%sのみ",
+		MsgEveryDayOfWeek:        "毎日",
+		MsgEveryNDaysOfWeek:      "%d日ごと",
+		MsgStartingOnWeekday:     "%[2]sから%[1]s",
+		MsgWeekdayRange:          "%sから%sまで",
+		MsgOnWeekdays:            "毎週%s",
+		MsgNthWeekday:            "毎月%s%s",
+		MsgLastDayOfWeek:         "毎週最終日",
+		MsgEveryYear:             "毎年",
+		MsgEveryNYears:           "%d年ごと",
+		MsgStartingInYear:        "%[2]d年から%[1]s",
+		MsgYearRange:             "%d年から%d年まで",
+		MsgInYears:               "%s年のみ",
+		MsgEverySecond:           "毎秒",
+		MsgEveryNSeconds:         "%d秒ごと",
+		MsgStartingAtSecond:      "%[2]d秒から%[1]s",
+		MsgSecondRange:           "毎分%d秒から%d秒まで毎秒",
+		MsgAtSecond:              "毎分%s秒",
+		MsgAtSeconds:             "毎分%s秒",
+		MsgSecondStepRange:       "毎分%[2]d秒から%[3]d秒まで%[1]s",
+		MsgMinuteStepRange:       "毎時%[2]d分から%[3]d分まで%[1]s",
+		MsgHourStepRange:         "%[2]sから%[3]sの間%[1]s",
+		MsgDayStepRange:          "毎月%[2]d日から%[3]d日まで%[1]s",
+		MsgMonthStepRange:        "%[2]sから%[3]sまで%[1]s",
+		MsgWeekdayStepRange:      "%[2]sから%[3]sまで%[1]s",
+		MsgYearStepRange:         "%[2]d年から%[3]d年まで%[1]s",
+		MsgLastWeekdayOfMonth:    "毎月最終平日",
+		MsgDayBeforeLastOfMonth:  "毎月末日の前日",
+		MsgDaysBeforeLastOfMonth: "毎月末日の%d日前",
+		MsgLastInstanceOfWeekday: "毎月最終%s",
+	},
+	Weekdays: [7]string{"日曜日", "月曜日", "火曜日", "水曜日", "木曜日", "金曜日", "土曜日"},
+	Months:   [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+	Ordinal: func(n int) string {
+		return fmt.Sprintf("第%d", n)
+	},
+	Join: func(items []string) string {
+		return joinList(items, "、", "と")
+	},
+	Separator:    "、",
+	LargestFirst: true,
+}
+
+var (
+	localesMu sync.RWMutex
+	locales   = map[string]*Locale{
+		"en": localeEnglish,
+		"ja": localeJapanese,
+	}
+)
+
+// RegisterLocale makes a locale available to DescribeLocale, replacing any locale of the same language.
+func RegisterLocale(lang string, l *Locale) {
+	localesMu.Lock()
+	defer localesMu.Unlock()
+	locales[strings.ToLower(lang)] = l
+}
+
+func lookupLocale(lang string) (*Locale, bool) {
+	localesMu.RLock()
+	defer localesMu.RUnlock()
+	lang = strings.ToLower(lang)
+
+	if l, ok := locales[lang]; ok {
+		return l, true
+	}
+
+	// "ja-JP" and "ja_JP" fall back to "ja"
+	if i := strings.IndexAny(lang, "-_"); i > 0 {
+		l, ok := locales[lang[:i]]
+		return l, ok
+	}
+
+	return nil, false
+}
+
+func (l *Locale) sprintf(msg Message, args ...interface{}) string {
+	format, ok := l.Messages[msg]
+
+	if !ok {
+		format = localeEnglish.Messages[msg]
+	}
+
+	return fmt.Sprintf(format, args...)
+}
+
+// every returns one when n is 1 and many otherwise.
+func (l *Locale) every(n int, one Message, many Message) string {
+	if n == 1 {
+		return l.sprintf(one)
+	}
+
+	return l.sprintf(many, n)
+}
+
+func (l *Locale) clock(hour int, minute int) string {
+	return l.sprintf(MsgClock, hour, minute)
+}
+
+func (l *Locale) weekday(w time.Weekday) string {
+	if l.Weekdays[w] != "" {
+		return l.Weekdays[w]
+	}
+
+	return localeEnglish.Weekdays[w]
+}
+
+func (l *Locale) month(m time.Month) string {
+	if l.Months[m-1] != "" {
+		return l.Months[m-1]
+	}
+
+	return localeEnglish.Months[m-1]
+}
+
+func (l *Locale) ordinal(n int) string {
+	if l.Ordinal != nil {
+		return l.Ordinal(n)
+	}
+
+	return localeEnglish.Ordinal(n)
+}
+
+func (l *Locale) join(items []string) string {
+	if l.Join != nil {
+		return l.Join(items)
+	}
+
+	return localeEnglish.Join(items)
+}
+
+func (l *Locale) separator() string {
+	if l.Separator != "" {
+		return l.Separator
+	}
+
+	return localeEnglish.Separator
+}
+
+// joinList joins items as "a", "a and b" or "a, b and c".
+func joinList(items []string, sep string, last string) string {
+	if len(items) <= 1 {
+		return strings.Join(items, "")
+	}
+
+	return strings.Join(items[:len(items)-1], sep) + last + items[len(items)-1]
+}
+
+func capitalize(s string) string {
+	r, size := utf8.DecodeRuneInString(s)
+	return string(unicode.ToUpper(r)) + s[size:]
+}
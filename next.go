@@ -16,81 +16,45 @@ func (v *Expression) Next(from time.Time) time.Time {
 
 func (v *Expression) NextN(from time.Time, n int) []time.Time {
 	schedule := []time.Time{}
-	years := v.candidateYears(from)
+	it := v.Iter(from)
 
-	if len(years) == 0 {
-		return schedule
-	}
+	for len(schedule) < n {
+		t, ok := it.Next()
 
-	months := v.candidateMonths(from)
+		if !ok {
+			break
+		}
 
-	if len(months) == 0 {
-		return schedule
+		schedule = append(schedule, t)
 	}
 
-	hours := v.candidateHours(from)
-
-	if len(hours) == 0 {
-		return schedule
-	}
+	return schedule
+}
 
-	minutes := v.candidateMinutes(from)
+// NextIn returns the next trigger of the expression evaluated in loc.
+// See IterIn for the handling of daylight saving time.
+func (v *Expression) NextIn(from time.Time, loc *time.Location) time.Time {
+	schedule := v.NextNIn(from, loc, 1)
 
-	if len(minutes) == 0 {
-		return schedule
+	if len(schedule) == 0 {
+		return time.Time{}
 	}
 
-	var DayMatch func(time.Time) bool
+	return schedule[0]
+}
+
+func (v *Expression) NextNIn(from time.Time, loc *time.Location, n int) []time.Time {
+	schedule := []time.Time{}
+	it := v.IterIn(from, loc)
 
-	if !v.DayOfMonth.HasAny() && v.DayOfWeek.HasAny() {
-		DayMatch = v.DayOfMonth.Match
-	} else if v.DayOfMonth.HasAny() && !v.DayOfWeek.HasAny() {
-		DayMatch = v.DayOfWeek.Match
-	} else {
-		return schedule
-	}
+	for len(schedule) < n {
+		t, ok := it.Next()
 
-YEAR:
-	for _, year := range years {
-		for _, month := range months {
-			if year == from.Year() && month < from.Month() {
-				continue
-			}
-
-			for day := 1; day <= 31; day++ {
-				if year == from.Year() && month == from.Month() && day < from.Day() {
-					continue
-				}
-
-				dayOfMonth := time.Date(year, time.Month(month), day, 0, 0, 0, 0, from.Location())
-
-				if dayOfMonth.Month() != month {
-					break
-				}
-
-				if !DayMatch(dayOfMonth) {
-					continue
-				}
-
-				for _, hour := range hours {
-					if year == from.Year() && month == from.Month() && day == from.Day() && hour < from.Hour() {
-						continue
-					}
-
-					for _, minute := range minutes {
-						if year == from.Year() && month == from.Month() && day == from.Day() && hour == from.Hour() && minute < from.Minute() {
-							continue
-						}
-
-						schedule = append(schedule, time.Date(year, time.Month(month), day, hour, minute, 0, 0, from.Location()))
-
-						if len(schedule) >= n {
-							break YEAR
-						}
-					}
-				}
-			}
+		if !ok {
+			break
 		}
+
+		schedule = append(schedule, t)
 	}
 
 	return schedule
@@ -151,3 +115,22 @@ func (v *Expression) candidateMinutes(from time.Time) []int {
 
 	return candidates
 }
+
+// candidateSeconds returns only 0 for an EventBridge expression, which fires at the start of the minute.
+func (v *Expression) candidateSeconds(from time.Time) []int {
+	if v.Seconds == nil {
+		return []int{0}
+	}
+
+	candidates := []int{}
+
+	for second := 0; second <= 59; second++ {
+		t := time.Date(from.Year(), from.Month(), from.Day(), from.Hour(), from.Minute(), second, 0, from.Location())
+
+		if v.Seconds.Match(t) {
+			candidates = append(candidates, second)
+		}
+	}
+
+	return candidates
+}
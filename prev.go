@@ -0,0 +1,138 @@
+package cronparse
+
+import (
+	"time"
+
+	"github.com/winebarrel/cronparse/utils"
+)
+
+func (v *Expression) Prev(from time.Time) time.Time {
+	schedule := v.PrevN(from, 1)
+
+	if len(schedule) == 0 {
+		return time.Time{}
+	}
+
+	return schedule[0]
+}
+
+func (v *Expression) PrevN(from time.Time, n int) []time.Time {
+	schedule := []time.Time{}
+	years := v.prevCandidateYears(from)
+
+	if len(years) == 0 {
+		return schedule
+	}
+
+	months := v.candidateMonths(from)
+
+	if len(months) == 0 {
+		return schedule
+	}
+
+	hours := v.candidateHours(from)
+
+	if len(hours) == 0 {
+		return schedule
+	}
+
+	minutes := v.candidateMinutes(from)
+
+	if len(minutes) == 0 {
+		return schedule
+	}
+
+	seconds := v.candidateSeconds(from)
+
+	if len(seconds) == 0 {
+		return schedule
+	}
+
+	if v.hasDayFieldConflict() {
+		return schedule
+	}
+
+	// An EventBridge expression includes the trigger at the start of from's minute
+	fromSecond := 59
+
+	if v.Seconds != nil {
+		fromSecond = from.Second()
+	}
+
+	DayMatch := v.DayOfMonth.Match
+
+	if v.DayOfMonth.HasAny() {
+		DayMatch = v.DayOfWeek.Match
+	}
+
+YEAR:
+	for _, year := range years {
+		for i := len(months) - 1; i >= 0; i-- {
+			month := months[i]
+
+			if year == from.Year() && month > from.Month() {
+				continue
+			}
+
+			lastDay := utils.LastOfMonth(time.Date(year, month, 1, 12, 0, 0, 0, from.Location()))
+
+			for day := lastDay; day >= 1; day-- {
+				if year == from.Year() && month == from.Month() && day > from.Day() {
+					continue
+				}
+
+				dayOfMonth := time.Date(year, month, day, 12, 0, 0, 0, from.Location())
+
+				if !DayMatch(dayOfMonth) {
+					continue
+				}
+
+				for j := len(hours) - 1; j >= 0; j-- {
+					hour := hours[j]
+
+					if year == from.Year() && month == from.Month() && day == from.Day() && hour > from.Hour() {
+						continue
+					}
+
+					for k := len(minutes) - 1; k >= 0; k-- {
+						minute := minutes[k]
+
+						if year == from.Year() && month == from.Month() && day == from.Day() && hour == from.Hour() && minute > from.Minute() {
+							continue
+						}
+
+						for l := len(seconds) - 1; l >= 0; l-- {
+							second := seconds[l]
+
+							if year == from.Year() && month == from.Month() && day == from.Day() && hour == from.Hour() && minute == from.Minute() && second > fromSecond {
+								continue
+							}
+
+							schedule = append(schedule, time.Date(year, month, day, hour, minute, second, 0, from.Location()))
+
+							if len(schedule) >= n {
+								break YEAR
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return schedule
+}
+
+func (v *Expression) prevCandidateYears(from time.Time) []int {
+	candidates := []int{}
+
+	for year := from.Year(); year >= 1970; year-- {
+		t := time.Date(year, 1, 1, 0, 0, 0, 0, from.Location())
+
+		if v.Year.Match(t) {
+			candidates = append(candidates, year)
+		}
+	}
+
+	return candidates
+}
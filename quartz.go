@@ -0,0 +1,112 @@
+package cronparse
+
+import (
+	"fmt"
+
+	"github.com/alecthomas/participle/v2"
+)
+
+var (
+	QuartzParser = participle.MustBuild[QuartzExpression](
+		participle.Lexer(cronLexer),
+	)
+)
+
+// QuartzExpression is the grammar of a Quartz cron expression: seconds first and an optional year.
+type QuartzExpression struct {
+	Seconds    *Seconds    `@@`
+	Minutes    *Minutes    `SP @@`
+	Hours      *Hours      `SP @@`
+	DayOfMonth *DayOfMonth `SP @@`
+	Month      *Month      `SP @@`
+	DayOfWeek  *DayOfWeek  `SP @@`
+	Year       *Year       `( SP @@ )?`
+}
+
+// Expression returns the expression for the trigger engine; a missing year matches every year,
+// but is still left out of String.
+func (v *QuartzExpression) Expression() *Expression {
+	year := v.Year
+
+	if year == nil {
+		year = &Year{Exps: []*YearExp{{CommonExp: CommonExp{All: &All{}}}}}
+	}
+
+	return &Expression{
+		Seconds:     v.Seconds,
+		Minutes:     v.Minutes,
+		Hours:       v.Hours,
+		DayOfMonth:  v.DayOfMonth,
+		Month:       v.Month,
+		DayOfWeek:   v.DayOfWeek,
+		Year:        year,
+		yearOmitted: v.Year == nil,
+	}
+}
+
+// ParseQuartz parses a Quartz cron expression, such as "0 0/15 9-17 ? * MON-FRI".
+// The result has Seconds set, so Next and the other trigger methods honour seconds.
+func ParseQuartz(exp string) (*Expression, error) {
+	q, err := QuartzParser.ParseString("", exp)
+
+	if err != nil {
+		return nil, newParseError(quartzDialect, exp, err)
+	}
+
+	cron := q.Expression()
+	err = cron.Validate()
+
+	if e := quartzYearOverflow(cron.Year); err == nil && e != nil {
+		err = &ValidationError{
+			Field:   FieldYear,
+			Token:   e.String(),
+			Message: fmt.Sprintf("is out of range (%d-%d)", FieldYear.Min(), quartzMaxYear),
+		}
+	}
+
+	if err != nil {
+		return nil, newParseError(quartzDialect, exp, err)
+	}
+
+	return cron, nil
+}
+
+const quartzMaxYear = 2099
+
+// quartzYearOverflow returns the first year that Quartz does not accept; Quartz years end at 2099.
+func quartzYearOverflow(v *Year) *YearExp {
+	for _, e := range v.Exps {
+		top := 0
+
+		if e.Number != nil {
+			top = e.Number.Value
+		} else if e.NumberRange != nil {
+			top = e.NumberRange.To
+		} else if e.NumberRangeIncrement != nil {
+			top = e.NumberRangeIncrement.To
+		} else if e.Increment != nil && !e.Increment.Wildcard {
+			top = e.Increment.Top
+		}
+
+		if top > quartzMaxYear {
+			return e
+		}
+	}
+
+	return nil
+}
+
+// clone returns a deep copy of the expression.
+func (v *Expression) clone() (*Expression, error) {
+	if v.Seconds != nil {
+		q, err := QuartzParser.ParseString("", v.String())
+
+		if err != nil {
+			return nil, err
+		}
+
+		return q.Expression(), nil
+	}
+
+	return Parser.ParseString("", v.String())
+}
@@ -0,0 +1,137 @@
+package cronparse
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/alecthomas/participle/v2"
+	"github.com/alecthomas/participle/v2/lexer"
+)
+
+var (
+	rateLexer = lexer.MustSimple([]lexer.SimpleRule{
+		{`Number`, `\d+`},
+		{`Ident`, `[a-zA-Z]+`},
+		{`Symbol`, `[()]`},
+		{`SP`, `\s+`},
+	})
+
+	RateParser = participle.MustBuild[RateExpression](
+		participle.Lexer(rateLexer),
+	)
+
+	ErrRateValue  = errors.New("rate value must be greater than 0")
+	ErrRateUnit   = errors.New("rate unit must be minute(s), hour(s) or day(s)")
+	ErrRatePlural = errors.New("rate unit must be singular for a value of 1 and plural otherwise")
+
+	rateUnits = map[string]time.Duration{
+		"minute":  time.Minute,
+		"minutes": time.Minute,
+		"hour":    time.Hour,
+		"hours":   time.Hour,
+		"day":     24 * time.Hour,
+		"days":    24 * time.Hour,
+	}
+)
+
+// rate
+type RateExpression struct {
+	Value int    `"rate" "(" @Number`
+	Unit  string `SP @Ident ")"`
+	// Anchor is the time from which the rate is counted.
+	// The Unix epoch is used if it is zero.
+	Anchor time.Time
+}
+
+func ParseRate(exp string) (*RateExpression, error) {
+	rate, err := RateParser.ParseString("", exp)
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = rate.Validate()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return rate, nil
+}
+
+func (v *RateExpression) Validate() error {
+	if v.Value < 1 {
+		return ErrRateValue
+	}
+
+	if _, ok := rateUnits[v.Unit]; !ok {
+		return ErrRateUnit
+	}
+
+	if plural := v.Unit[len(v.Unit)-1] == 's'; plural != (v.Value > 1) {
+		return ErrRatePlural
+	}
+
+	return nil
+}
+
+func (v *RateExpression) String() string {
+	return fmt.Sprintf("rate(%d %s)", v.Value, v.Unit)
+}
+
+func (v *RateExpression) Interval() time.Duration {
+	return time.Duration(v.Value) * rateUnits[v.Unit]
+}
+
+func (v *RateExpression) anchor() time.Time {
+	if v.Anchor.IsZero() {
+		return time.Unix(0, 0)
+	}
+
+	return v.Anchor.Truncate(time.Minute)
+}
+
+func (v *RateExpression) Match(t time.Time) bool {
+	interval := v.Interval()
+
+	if interval <= 0 {
+		return false
+	}
+
+	d := t.Truncate(time.Minute).Sub(v.anchor())
+	return d >= 0 && d%interval == 0
+}
+
+func (v *RateExpression) Next(from time.Time) time.Time {
+	schedule := v.NextN(from, 1)
+
+	if len(schedule) == 0 {
+		return time.Time{}
+	}
+
+	return schedule[0]
+}
+
+func (v *RateExpression) NextN(from time.Time, n int) []time.Time {
+	schedule := []time.Time{}
+	interval := v.Interval()
+
+	if interval <= 0 {
+		return schedule
+	}
+
+	anchor := v.anchor()
+	next := anchor
+
+	if d := from.Truncate(time.Minute).Sub(anchor); d > 0 {
+		next = anchor.Add((d + interval - 1) / interval * interval)
+	}
+
+	for len(schedule) < n {
+		schedule = append(schedule, next.In(from.Location()))
+		next = next.Add(interval)
+	}
+
+	return schedule
+}
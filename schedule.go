@@ -0,0 +1,322 @@
+package cronparse
+
+import (
+	"math/bits"
+	"time"
+)
+
+const (
+	minYear = 1970
+	maxYear = 2199
+)
+
+// Schedule is an immutable, compiled form of Expression.
+// Each field is kept as a bitmask so that Match and Next do not walk the AST.
+type Schedule struct {
+	seconds  uint64    // bit n = second n
+	minutes  uint64    // bit n = minute n
+	hours    uint64    // bit n = hour n
+	days     uint64    // bit n = day of month n
+	weekdays uint64    // bit n = time.Weekday(n)
+	months   uint64    // bit n = time.Month(n)
+	years    [4]uint64 // bit n = year 1970+n
+	dow      bool      // day of week is restricted instead of day of month
+	quartz   bool      // seconds are restricted; otherwise the schedule fires at second 0
+	// dayMatch is set when the day field depends on the month (L, W and #)
+	dayMatch func(time.Time) bool
+}
+
+func (v *Expression) Compile() (*Schedule, error) {
+	err := v.Validate()
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Copy the expression so that changes to the AST do not leak into the schedule.
+	v, err = v.clone()
+
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Schedule{dow: v.DayOfMonth.HasAny(), quartz: v.Seconds != nil, seconds: 1}
+
+	if s.quartz {
+		s.seconds = 0
+
+		for second := 0; second <= 59; second++ {
+			if v.Seconds.Match(time.Date(2000, 1, 1, 0, 0, second, 0, time.UTC)) {
+				s.seconds |= 1 << second
+			}
+		}
+	}
+
+	for minute := 0; minute <= 59; minute++ {
+		if v.Minutes.Match(time.Date(2000, 1, 1, 0, minute, 0, 0, time.UTC)) {
+			s.minutes |= 1 << minute
+		}
+	}
+
+	for hour := 0; hour <= 23; hour++ {
+		if v.Hours.Match(time.Date(2000, 1, 1, hour, 0, 0, 0, time.UTC)) {
+			s.hours |= 1 << hour
+		}
+	}
+
+	for month := time.January; month <= time.December; month++ {
+		if v.Month.Match(time.Date(2000, month, 1, 0, 0, 0, 0, time.UTC)) {
+			s.months |= 1 << month
+		}
+	}
+
+	for year := minYear; year <= maxYear; year++ {
+		if v.Year.Match(time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC)) {
+			s.years[(year-minYear)/64] |= 1 << ((year - minYear) % 64)
+		}
+	}
+
+	if s.dow {
+		if v.DayOfWeek.static() {
+			// 2000-01-02 is a Sunday
+			for w := time.Sunday; w <= time.Saturday; w++ {
+				if v.DayOfWeek.Match(time.Date(2000, 1, 2+int(w), 0, 0, 0, 0, time.UTC)) {
+					s.weekdays |= 1 << w
+				}
+			}
+		} else {
+			s.dayMatch = v.DayOfWeek.Match
+		}
+	} else {
+		if v.DayOfMonth.static() {
+			for day := 1; day <= 31; day++ {
+				if v.DayOfMonth.Match(time.Date(2000, 1, day, 0, 0, 0, 0, time.UTC)) {
+					s.days |= 1 << day
+				}
+			}
+		} else {
+			s.dayMatch = v.DayOfMonth.Match
+		}
+	}
+
+	return s, nil
+}
+
+// static reports whether the day of month does not depend on the month.
+func (v *DayOfMonth) static() bool {
+	for _, e := range v.Exps {
+		if !e.CommonExp.Present() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// static reports whether the day of week does not depend on the month.
+func (v *DayOfWeek) static() bool {
+	for _, e := range v.Exps {
+		if e.LastInstance != nil || e.Instance != nil || e.Last != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+// dayMask returns the days of the month that the schedule fires on.
+func (s *Schedule) dayMask(year int, month time.Month, loc *time.Location) uint64 {
+	first := time.Date(year, month, 1, 12, 0, 0, 0, loc)
+	last := first.AddDate(0, 1, -1).Day()
+	var mask uint64
+
+	if s.dayMatch != nil {
+		for day := 1; day <= last; day++ {
+			if s.dayMatch(time.Date(year, month, day, 12, 0, 0, 0, loc)) {
+				mask |= 1 << day
+			}
+		}
+	} else if s.dow {
+		for w := time.Sunday; w <= time.Saturday; w++ {
+			if s.weekdays&(1<<w) == 0 {
+				continue
+			}
+
+			for day := 1 + (int(w)-int(first.Weekday())+7)%7; day <= last; day += 7 {
+				mask |= 1 << day
+			}
+		}
+	} else {
+		mask = s.days
+	}
+
+	return mask & (1<<(last+1) - 1)
+}
+
+// nextBit returns the lowest set bit of mask at or above from.
+func nextBit(mask uint64, from int) (int, bool) {
+	if from >= 64 {
+		return 0, false
+	}
+
+	mask = mask >> from << from
+
+	if mask == 0 {
+		return 0, false
+	}
+
+	return bits.TrailingZeros64(mask), true
+}
+
+func (s *Schedule) nextYear(from int) (int, bool) {
+	if from < minYear {
+		from = minYear
+	}
+
+	for i := (from - minYear) / 64; i < len(s.years); i++ {
+		start := 0
+
+		if i == (from-minYear)/64 {
+			start = (from - minYear) % 64
+		}
+
+		if bit, ok := nextBit(s.years[i], start); ok {
+			return minYear + i*64 + bit, true
+		}
+	}
+
+	return 0, false
+}
+
+// next returns the first trigger at or after the given wall clock time.
+// The arguments may overflow their ranges by one; the overflow carries to the next larger field.
+func (s *Schedule) next(year int, month time.Month, day int, hour int, minute int, second int, loc *time.Location) (int, time.Month, int, int, int, int, bool) {
+	for {
+		y, ok := s.nextYear(year)
+
+		if !ok {
+			return 0, 0, 0, 0, 0, 0, false
+		}
+
+		if y != year {
+			year, month, day, hour, minute, second = y, time.January, 1, 0, 0, 0
+		}
+
+		m, ok := nextBit(s.months, int(month))
+
+		if !ok {
+			year, month, day, hour, minute, second = year+1, time.January, 1, 0, 0, 0
+			continue
+		}
+
+		if time.Month(m) != month {
+			month, day, hour, minute, second = time.Month(m), 1, 0, 0, 0
+		}
+
+		d, ok := nextBit(s.dayMask(year, month, loc), day)
+
+		if !ok {
+			month, day, hour, minute, second = month+1, 1, 0, 0, 0
+			continue
+		}
+
+		if d != day {
+			day, hour, minute, second = d, 0, 0, 0
+		}
+
+		h, ok := nextBit(s.hours, hour)
+
+		if !ok {
+			day, hour, minute, second = day+1, 0, 0, 0
+			continue
+		}
+
+		if h != hour {
+			hour, minute, second = h, 0, 0
+		}
+
+		mi, ok := nextBit(s.minutes, minute)
+
+		if !ok {
+			hour, minute, second = hour+1, 0, 0
+			continue
+		}
+
+		if mi != minute {
+			minute, second = mi, 0
+		}
+
+		sec, ok := nextBit(s.seconds, second)
+
+		if !ok {
+			minute, second = minute+1, 0
+			continue
+		}
+
+		return year, month, day, hour, minute, sec, true
+	}
+}
+
+func (s *Schedule) Next(from time.Time) time.Time {
+	schedule := s.NextN(from, 1)
+
+	if len(schedule) == 0 {
+		return time.Time{}
+	}
+
+	return schedule[0]
+}
+
+func (s *Schedule) NextN(from time.Time, n int) []time.Time {
+	schedule := []time.Time{}
+	year, month, day, hour, minute, second := from.Year(), from.Month(), from.Day(), from.Hour(), from.Minute(), 0
+
+	if s.quartz {
+		second = from.Second()
+	}
+
+	for len(schedule) < n {
+		var ok bool
+		year, month, day, hour, minute, second, ok = s.next(year, month, day, hour, minute, second, from.Location())
+
+		if !ok {
+			break
+		}
+
+		schedule = append(schedule, time.Date(year, month, day, hour, minute, second, 0, from.Location()))
+		second++
+	}
+
+	return schedule
+}
+
+func (s *Schedule) Match(t time.Time) bool {
+	year, month, day := t.Date()
+	hour, minute, second := t.Clock()
+
+	if year < minYear || maxYear < year {
+		return false
+	}
+
+	if s.quartz && s.seconds&(1<<second) == 0 {
+		return false
+	}
+
+	idx := year - minYear
+
+	return s.minutes&(1<<minute) != 0 &&
+		s.hours&(1<<hour) != 0 &&
+		s.months&(1<<month) != 0 &&
+		s.years[idx/64]&(1<<(idx%64)) != 0 &&
+		s.matchDay(t, day)
+}
+
+func (s *Schedule) matchDay(t time.Time, day int) bool {
+	if s.dayMatch != nil {
+		return s.dayMatch(t)
+	} else if s.dow {
+		return s.weekdays&(1<<t.Weekday()) != 0
+	}
+
+	return s.days&(1<<day) != 0
+}
@@ -0,0 +1,85 @@
+package cronparse
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// ScheduleExpression is implemented by every kind of Amazon EventBridge schedule expression.
+type ScheduleExpression interface {
+	String() string
+	Match(t time.Time) bool
+	Next(from time.Time) time.Time
+	NextN(from time.Time, n int) []time.Time
+}
+
+var (
+	_ ScheduleExpression = &Expression{}
+	_ ScheduleExpression = &CronExpression{}
+	_ ScheduleExpression = &RateExpression{}
+	_ ScheduleExpression = &AtExpression{}
+)
+
+// cron
+type CronExpression struct {
+	*Expression
+}
+
+func (v *CronExpression) String() string {
+	return "cron(" + v.Expression.String() + ")"
+}
+
+// ParseScheduleExpression parses a schedule expression in the form used by CloudFormation, Terraform and the AWS API,
+// i.e. "cron(...)", "rate(...)" or "at(...)". An expression without a wrapper is parsed as a cron expression.
+func ParseScheduleExpression(exp string) (ScheduleExpression, error) {
+	// Return an untyped nil on error; a nil *RateExpression in the interface would not compare equal to nil.
+	if strings.HasPrefix(exp, "rate(") {
+		rate, err := ParseRate(exp)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return rate, nil
+	} else if strings.HasPrefix(exp, "at(") {
+		at, err := ParseAt(exp)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return at, nil
+	} else if !strings.HasPrefix(exp, "cron(") {
+		cron, err := Parse(exp)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return cron, nil
+	}
+
+	inner := strings.TrimPrefix(exp, "cron(")
+
+	if !strings.HasSuffix(inner, ")") {
+		index := eventBridgeDialect.endIndex(inner)
+
+		return nil, &ParseError{Offset: len(exp), Index: index, Field: eventBridgeDialect.field(index), msg: `missing ")"`}
+	}
+
+	cron, err := Parse(strings.TrimSuffix(inner, ")"))
+
+	if err != nil {
+		var perr *ParseError
+
+		// Point the offset at the original expression
+		if errors.As(err, &perr) {
+			perr.Offset += len("cron(")
+		}
+
+		return nil, err
+	}
+
+	return &CronExpression{cron}, nil
+}
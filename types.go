@@ -5,6 +5,8 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/alecthomas/participle/v2"
+	"github.com/alecthomas/participle/v2/lexer"
 	"github.com/winebarrel/cronparse/utils"
 )
 
@@ -44,7 +46,7 @@ func (v *WeekName) String() string {
 }
 
 func (v *WeekName) Match(x time.Weekday) bool {
-	return utils.WeekNameToNumber(v.Value)%7 == int(x)
+	return weekNameToDayOfWeek(v.Value) == dayOfWeek(x)
 }
 
 // number range
@@ -58,7 +60,7 @@ func (v *NumberRange) String() string {
 }
 
 func (v *NumberRange) Match(x int) bool {
-	return v.From <= x && x <= v.To
+	return inRange(v.From, v.To, x)
 }
 
 // week range
@@ -72,15 +74,7 @@ func (v *WeekRange) String() string {
 }
 
 func (v *WeekRange) Match(x time.Weekday) bool {
-	from := utils.WeekNameToNumber(v.From) % 7
-	to := utils.WeekNameToNumber(v.To)
-	n := int(x)
-
-	if from != 0 && x == time.Sunday {
-		n = 7
-	}
-
-	return from <= n && n <= to
+	return inRange(weekNameToDayOfWeek(v.From), weekNameToDayOfWeek(v.To), dayOfWeek(x))
 }
 
 // month range
@@ -94,9 +88,111 @@ func (v *MonthRange) String() string {
 }
 
 func (v *MonthRange) Match(x time.Month) bool {
-	from := utils.MonthNameToNumber(v.From)
-	to := utils.MonthNameToNumber(v.To)
-	return from <= int(x) && int(x) <= to
+	return inRange(utils.MonthNameToNumber(v.From), utils.MonthNameToNumber(v.To), int(x))
+}
+
+// number range increment
+type NumberRangeIncrement struct {
+	From   int
+	To     int
+	Buttom int
+}
+
+func (v *NumberRangeIncrement) Parse(lex *lexer.PeekingLexer) error {
+	from, to, buttom, err := parseRangeIncrement(lex, "Number")
+
+	if err != nil {
+		return err
+	}
+
+	v.From, _ = strconv.Atoi(from)
+	v.To, _ = strconv.Atoi(to)
+	v.Buttom = buttom
+
+	return nil
+}
+
+func (v *NumberRangeIncrement) String() string {
+	return fmt.Sprintf("%d-%d/%d", v.From, v.To, v.Buttom)
+}
+
+func (v *NumberRangeIncrement) Match(x int, f Field) bool {
+	return stepInRange(v.From, v.To, v.Buttom, x, f)
+}
+
+// week range increment
+type WeekRangeIncrement struct {
+	From   string
+	To     string
+	Buttom int
+}
+
+func (v *WeekRangeIncrement) Parse(lex *lexer.PeekingLexer) (err error) {
+	v.From, v.To, v.Buttom, err = parseRangeIncrement(lex, "Week")
+	return
+}
+
+func (v *WeekRangeIncrement) String() string {
+	return fmt.Sprintf("%s-%s/%d", v.From, v.To, v.Buttom)
+}
+
+func (v *WeekRangeIncrement) Match(x time.Weekday) bool {
+	return stepInRange(weekNameToDayOfWeek(v.From), weekNameToDayOfWeek(v.To), v.Buttom, dayOfWeek(x), FieldDayOfWeek)
+}
+
+// month range increment
+type MonthRangeIncrement struct {
+	From   string
+	To     string
+	Buttom int
+}
+
+func (v *MonthRangeIncrement) Parse(lex *lexer.PeekingLexer) (err error) {
+	v.From, v.To, v.Buttom, err = parseRangeIncrement(lex, "Month")
+	return
+}
+
+func (v *MonthRangeIncrement) String() string {
+	return fmt.Sprintf("%s-%s/%d", v.From, v.To, v.Buttom)
+}
+
+func (v *MonthRangeIncrement) Match(x time.Month) bool {
+	return stepInRange(utils.MonthNameToNumber(v.From), utils.MonthNameToNumber(v.To), v.Buttom, int(x), FieldMonth)
+}
+
+// parseRangeIncrement parses "from-to/step", where from and to are tokens of the given type.
+// The grammar cannot backtrack past the first token of an alternative,
+// so the whole "from-to/" prefix is peeked before anything is consumed; otherwise a plain range is tried.
+func parseRangeIncrement(lex *lexer.PeekingLexer, tokenType string) (string, string, int, error) {
+	symbols := cronLexer.Symbols()
+	peek := lex.Clone()
+	from := peek.Next()
+	dash := peek.Next()
+	to := peek.Next()
+	slash := peek.Next()
+
+	if from.Type != symbols[tokenType] || dash.Value != "-" || to.Type != symbols[tokenType] || slash.Value != "/" {
+		return "", "", 0, participle.NextMatch
+	}
+
+	for i := 0; i < 4; i++ {
+		lex.Next()
+	}
+
+	step := lex.Peek()
+
+	if step.Type != symbols["Number"] {
+		return "", "", 0, &participle.UnexpectedTokenError{Unexpected: step}
+	}
+
+	lex.Next()
+	buttom, err := strconv.Atoi(step.Value)
+
+	if err != nil {
+		return "", "", 0, participle.Errorf(step.Pos, "invalid step %q", step.Value)
+	}
+
+	return from.Value, to.Value, buttom, nil
 }
 
 // all
@@ -163,6 +259,32 @@ func (v *LastOfMonth) Match(t time.Time) bool {
 	return utils.LastOfMonth(t) == t.Day()
 }
 
+// last weekday of month
+type LastWeekdayOfMonth struct {
+	Value struct{} `"L" "W"`
+}
+
+func (v *LastWeekdayOfMonth) String() string {
+	return "LW"
+}
+
+func (v *LastWeekdayOfMonth) Match(t time.Time) bool {
+	return utils.LastBusinessDayOfMonth(t) == t.Day()
+}
+
+// last of month offset
+type LastOfMonthOffset struct {
+	Offset int `"L" "-" @Number`
+}
+
+func (v *LastOfMonthOffset) String() string {
+	return fmt.Sprintf("L-%d", v.Offset)
+}
+
+func (v *LastOfMonthOffset) Match(t time.Time) bool {
+	return utils.LastOfMonth(t)-v.Offset == t.Day()
+}
+
 // last of week
 type LastOfWeek struct {
 	Value struct{} `"L"`
@@ -186,6 +308,11 @@ func (v *Weekday) String() string {
 }
 
 func (v *Weekday) Match(base time.Time) bool {
+	// The day does not exist in this month, e.g. 31W in April
+	if v.Value > utils.LastOfMonth(base) {
+		return false
+	}
+
 	t := time.Date(base.Year(), base.Month(), v.Value, 0, 0, 0, 0, time.UTC)
 	return utils.NearestWeekday(t) == base.Day()
 }
@@ -201,5 +328,67 @@ func (v *Instance) String() string {
 }
 
 func (v *Instance) Match(t time.Time) bool {
-	return utils.NthDayOfWeek(t, time.Weekday(v.DayOfWeek), v.NthDayOfWeek) == t.Day()
+	return utils.NthDayOfWeek(t, time.Weekday(v.DayOfWeek-1), v.NthDayOfWeek) == t.Day()
+}
+
+// last instance
+type LastInstance struct {
+	DayOfWeek int    `( @Number`
+	Name      string `| @Week ) ( "L" | "l" )`
+}
+
+func (v *LastInstance) String() string {
+	if v.Name != "" {
+		return v.Name + "L"
+	}
+
+	return fmt.Sprintf("%dL", v.DayOfWeek)
+}
+
+func (v *LastInstance) Match(t time.Time) bool {
+	return utils.LastWeekdayOfMonth(t, v.weekday()) == t.Day()
+}
+
+func (v *LastInstance) weekday() time.Weekday {
+	if v.Name != "" {
+		return time.Weekday(weekNameToDayOfWeek(v.Name) - 1)
+	}
+
+	return time.Weekday(v.DayOfWeek - 1)
+}
+
+// dayOfWeek returns the day-of-week number of Amazon EventBridge, 1 (SUN) to 7 (SAT).
+func dayOfWeek(w time.Weekday) int {
+	return int(w) + 1
+}
+
+// weekNameToDayOfWeek returns the day-of-week number of Amazon EventBridge for a name such as MON.
+func weekNameToDayOfWeek(s string) int {
+	return utils.WeekNameToNumber(s)%7 + 1
+}
+
+// inRange reports whether x is between from and to.
+// A reversed range such as 22-2 or NOV-FEB wraps around the end of the field.
+func inRange(from int, to int, x int) bool {
+	if from > to {
+		return x >= from || x <= to
+	}
+
+	return from <= x && x <= to
+}
+
+// stepInRange reports whether x is between from and to and a multiple of step away from from.
+// A reversed range such as 22-2/2 keeps stepping across the end of the field: 22, 0, 2.
+func stepInRange(from int, to int, step int, x int, f Field) bool {
+	if !inRange(from, to, x) {
+		return false
+	}
+
+	d := x - from
+
+	if d < 0 {
+		d += f.Max() - f.Min() + 1
+	}
+
+	return d%step == 0
 }
@@ -0,0 +1,282 @@
+package cronparse
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/winebarrel/cronparse/utils"
+)
+
+var (
+	ErrUnixFields           = errors.New("a crontab entry must have 5 fields: minute hour day-of-month month day-of-week")
+	ErrUnixMacro            = errors.New("unsupported crontab macro")
+	ErrUnixDayFieldConflict = errors.New("a crontab entry that restricts both day-of-month and day-of-week cannot be expressed in EventBridge")
+)
+
+var unixMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// FromUnixCron converts a 5-field Unix crontab entry, such as "*/5 * * * 1-5" or "@daily", to an EventBridge expression.
+//
+// Day-of-week is shifted from Unix (0-7, 0 and 7 are Sunday) to EventBridge (1-7, 1 is Sunday),
+// '?' is put into the unrestricted day field, and the year field is appended.
+// Unix cron fires when either restricted day field matches, which EventBridge cannot express, so such entries are rejected.
+func FromUnixCron(crontab string) (*Expression, error) {
+	crontab = strings.TrimSpace(crontab)
+
+	if strings.HasPrefix(crontab, "@") {
+		m, ok := unixMacros[strings.ToLower(crontab)]
+
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnixMacro, crontab)
+		}
+
+		crontab = m
+	}
+
+	fields := strings.Fields(crontab)
+
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("%w: %q", ErrUnixFields, crontab)
+	}
+
+	monthName := func(s string) int { return utils.MonthNameToNumber(s) }
+	// Unix names the days of the week SUN=0..SAT=6
+	weekName := func(s string) int { return utils.WeekNameToNumber(s) % 7 }
+
+	minutes, err := fromUnixField(fields[0], nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	hours, err := fromUnixField(fields[1], nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	dayOfMonth, err := fromUnixField(fields[2], nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	month, err := fromUnixField(fields[3], monthName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	dayOfWeek, err := fromUnixDayOfWeek(fields[4], weekName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if dayOfWeek == "*" {
+		dayOfWeek = "?"
+	} else if dayOfMonth == "*" {
+		dayOfMonth = "?"
+	} else {
+		return nil, fmt.Errorf("%w: %q", ErrUnixDayFieldConflict, crontab)
+	}
+
+	exp := strings.Join([]string{minutes, hours, dayOfMonth, month, dayOfWeek, "*"}, " ")
+	cron, err := Parse(exp)
+
+	if err != nil {
+		return nil, fmt.Errorf("%q: %w", exp, err)
+	}
+
+	return cron, nil
+}
+
+// fromUnixField converts a field other than day-of-week, whose values mean the same in both formats.
+// Unix ranges never wrap, so a reversed range such as "22-2" is rejected instead of being passed on to EventBridge, which would wrap it.
+func fromUnixField(field string, name func(string) int) (string, error) {
+	for _, item := range strings.Split(field, ",") {
+		rng, step, hasStep := strings.Cut(item, "/")
+
+		if hasStep {
+			if _, err := unixStepValue(step); err != nil {
+				return "", err
+			}
+		}
+
+		if strings.Contains(rng, "-") {
+			if _, _, err := unixRange(rng, name); err != nil {
+				return "", err
+			}
+		}
+	}
+
+	return field, nil
+}
+
+// fromUnixDayOfWeek converts day-of-week numbers from Unix (0-7) to EventBridge (1-7).
+func fromUnixDayOfWeek(field string, name func(string) int) (string, error) {
+	items := strings.Split(field, ",")
+
+	for i, item := range items {
+		rng, step, hasStep := strings.Cut(item, "/")
+
+		if rng == "*" || (!hasStep && utils.WeekNameToNumber(rng) > 0) {
+			// "*", "*/n" and names mean the same in both formats
+			continue
+		}
+
+		if !hasStep && isWeekRange(rng) {
+			// So do name ranges, as long as they are not reversed
+			if _, _, err := unixRange(rng, name); err != nil {
+				return "", err
+			}
+
+			continue
+		}
+
+		var from, to int
+		var err error
+
+		if strings.Contains(rng, "-") {
+			from, to, err = unixRange(rng, name)
+		} else {
+			// "n/m" runs to the end of the week
+			from, err = unixValue(rng, name)
+			to = from
+
+			if hasStep {
+				to = 7
+			}
+		}
+
+		if err != nil {
+			return "", err
+		}
+
+		if !hasStep {
+			step = "1"
+		}
+
+		values, err := unixStep(from, to, step)
+
+		if err != nil {
+			return "", err
+		}
+
+		items[i] = fromUnixWeekdays(values)
+	}
+
+	return strings.Join(items, ","), nil
+}
+
+// fromUnixWeekdays shifts Unix day-of-week numbers to EventBridge, keeping a contiguous run as a range.
+func fromUnixWeekdays(values []int) string {
+	days := []int{}
+	seen := map[int]bool{}
+
+	for _, v := range values {
+		d := v%7 + 1
+
+		if !seen[d] {
+			seen[d] = true
+			days = append(days, d)
+		}
+	}
+
+	sort.Ints(days)
+
+	if len(days) > 1 && days[len(days)-1]-days[0] == len(days)-1 {
+		return fmt.Sprintf("%d-%d", days[0], days[len(days)-1])
+	}
+
+	return joinInts(days)
+}
+
+func isWeekRange(s string) bool {
+	from, to, ok := strings.Cut(s, "-")
+	return ok && utils.WeekNameToNumber(from) > 0 && utils.WeekNameToNumber(to) > 0
+}
+
+func unixRange(s string, name func(string) int) (int, int, error) {
+	from, to, _ := strings.Cut(s, "-")
+	f, err := unixValue(from, name)
+
+	if err != nil {
+		return 0, 0, err
+	}
+
+	t, err := unixValue(to, name)
+
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if f > t {
+		return 0, 0, fmt.Errorf("invalid crontab range: %q must not be reversed", s)
+	}
+
+	return f, t, nil
+}
+
+func unixValue(s string, name func(string) int) (int, error) {
+	if name != nil {
+		if n := name(s); n >= 0 {
+			return n, nil
+		}
+	}
+
+	n, err := strconv.Atoi(s)
+
+	if err != nil {
+		return 0, fmt.Errorf("invalid crontab value: %q", s)
+	}
+
+	return n, nil
+}
+
+func unixStep(from int, to int, step string) ([]int, error) {
+	n, err := unixStepValue(step)
+
+	if err != nil {
+		return nil, err
+	}
+
+	values := []int{}
+
+	for v := from; v <= to; v += n {
+		values = append(values, v)
+	}
+
+	return values, nil
+}
+
+func unixStepValue(step string) (int, error) {
+	n, err := strconv.Atoi(step)
+
+	if err != nil || n < 1 {
+		return 0, fmt.Errorf("invalid crontab step: %q", step)
+	}
+
+	return n, nil
+}
+
+func joinInts(values []int) string {
+	strs := make([]string, 0, len(values))
+
+	for _, v := range values {
+		strs = append(strs, strconv.Itoa(v))
+	}
+
+	return strings.Join(strs, ",")
+}
@@ -34,19 +34,59 @@ func LastOfMonth(t time.Time) int {
 	return t.AddDate(0, 1, -t.Day()).Day()
 }
 
+// LastBusinessDayOfMonth returns the last day of the month that is Monday to Friday.
+func LastBusinessDayOfMonth(t time.Time) int {
+	last := time.Date(t.Year(), t.Month(), LastOfMonth(t), 0, 0, 0, 0, time.UTC)
+
+	switch last.Weekday() {
+	case time.Saturday:
+		return last.Day() - 1
+	case time.Sunday:
+		return last.Day() - 2
+	}
+
+	return last.Day()
+}
+
+// LastWeekdayOfMonth returns the last day of the month that falls on w.
+func LastWeekdayOfMonth(t time.Time, w time.Weekday) int {
+	last := time.Date(t.Year(), t.Month(), LastOfMonth(t), 0, 0, 0, 0, time.UTC)
+	offset := (last.Weekday() + 7 - w) % 7
+	return last.Day() - int(offset)
+}
+
+// NearestWeekday returns the weekday (Monday to Friday) nearest to the day of t without leaving its month.
+// As in Quartz, a Saturday the 1st moves to Monday the 3rd and a Sunday on the last day moves to the Friday before.
 func NearestWeekday(t time.Time) int {
-	if t.Weekday() == time.Saturday {
-		return t.AddDate(0, 0, -1).Day()
-	} else if t.Weekday() == time.Sunday {
-		return t.AddDate(0, 0, 1).Day()
+	day := t.Day()
+
+	switch t.Weekday() {
+	case time.Saturday:
+		if day == 1 {
+			return day + 2
+		}
+
+		return day - 1
+	case time.Sunday:
+		if day == LastOfMonth(t) {
+			return day - 2
+		}
+
+		return day + 1
 	}
 
-	return t.Day()
+	return day
 }
 
+// NthDayOfWeek returns the day of the nth w in the month, or 0 when the month has fewer than nth of them.
 func NthDayOfWeek(t time.Time, w time.Weekday, nth int) int {
 	firstOfMonth := time.Date(t.Year(), t.Month(), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
 	offset := (w + 7 - firstOfMonth.Weekday()) % 7
 	nthDoW := firstOfMonth.AddDate(0, 0, 7*(nth-1)+int(offset))
+
+	if nthDoW.Month() != t.Month() {
+		return 0
+	}
+
 	return nthDoW.Day()
 }
@@ -95,6 +95,56 @@ func TestLastOfMonth(t *testing.T) {
 	}
 }
 
+func TestLastBusinessDayOfMonth(t *testing.T) {
+	assert := assert.New(t)
+
+	tt := []struct {
+		tm       time.Time
+		expected int
+	}{
+		// the last day is a weekday
+		{time.Date(2023, 1, 1, 9, 0, 0, 0, time.UTC), 31},
+		{time.Date(2023, 2, 1, 9, 0, 0, 0, time.UTC), 28},
+		{time.Date(2024, 2, 1, 9, 0, 0, 0, time.UTC), 29},
+		// the last day is a Saturday
+		{time.Date(2023, 9, 1, 9, 0, 0, 0, time.UTC), 29},
+		{time.Date(2020, 2, 1, 9, 0, 0, 0, time.UTC), 28},
+		// the last day is a Sunday
+		{time.Date(2023, 4, 1, 9, 0, 0, 0, time.UTC), 28},
+		{time.Date(2032, 2, 1, 9, 0, 0, 0, time.UTC), 27},
+		{time.Date(2021, 2, 15, 9, 0, 0, 0, time.UTC), 26},
+	}
+
+	for _, t := range tt {
+		assert.Equal(t.expected, utils.LastBusinessDayOfMonth(t.tm), t.tm)
+	}
+}
+
+func TestLastWeekdayOfMonth(t *testing.T) {
+	assert := assert.New(t)
+
+	tt := []struct {
+		tm       time.Time
+		w        time.Weekday
+		expected int
+	}{
+		// 2023-09-30 is a Saturday
+		{time.Date(2023, 9, 1, 9, 0, 0, 0, time.UTC), time.Saturday, 30},
+		{time.Date(2023, 9, 1, 9, 0, 0, 0, time.UTC), time.Friday, 29},
+		{time.Date(2023, 9, 1, 9, 0, 0, 0, time.UTC), time.Sunday, 24},
+		// 2024-02-29 is a Thursday
+		{time.Date(2024, 2, 1, 9, 0, 0, 0, time.UTC), time.Thursday, 29},
+		{time.Date(2024, 2, 1, 9, 0, 0, 0, time.UTC), time.Friday, 23},
+		// 2023-02-28 is a Tuesday
+		{time.Date(2023, 2, 1, 9, 0, 0, 0, time.UTC), time.Wednesday, 22},
+		{time.Date(2023, 2, 1, 9, 0, 0, 0, time.UTC), time.Monday, 27},
+	}
+
+	for _, t := range tt {
+		assert.Equal(t.expected, utils.LastWeekdayOfMonth(t.tm, t.w), t.tm, t.w)
+	}
+}
+
 func TestNearestWeekday(t *testing.T) {
 	assert := assert.New(t)
 
@@ -108,6 +158,17 @@ func TestNearestWeekday(t *testing.T) {
 		{time.Date(2022, 11, 6, 9, 0, 0, 0, time.UTC), 7},
 		{time.Date(2022, 11, 7, 9, 0, 0, 0, time.UTC), 7},
 		{time.Date(2022, 11, 8, 9, 0, 0, 0, time.UTC), 8},
+		// Saturday the 1st does not move back to the previous month
+		{time.Date(2022, 10, 1, 9, 0, 0, 0, time.UTC), 3},
+		// Sunday the 1st
+		{time.Date(2023, 1, 1, 9, 0, 0, 0, time.UTC), 2},
+		// Saturday on the last day
+		{time.Date(2023, 9, 30, 9, 0, 0, 0, time.UTC), 29},
+		// Sunday on the last day does not move forward to the next month
+		{time.Date(2023, 12, 31, 9, 0, 0, 0, time.UTC), 29},
+		{time.Date(2023, 4, 30, 9, 0, 0, 0, time.UTC), 28},
+		{time.Date(2026, 2, 28, 9, 0, 0, 0, time.UTC), 27},
+		{time.Date(2032, 2, 29, 9, 0, 0, 0, time.UTC), 27},
 	}
 
 	for _, t := range tt {
@@ -115,6 +176,56 @@ func TestNearestWeekday(t *testing.T) {
 	}
 }
 
+func TestNearestWeekdayEveryMonthLayout(t *testing.T) {
+	assert := assert.New(t)
+	// a month layout is the weekday of the 1st and the number of days
+	type layout struct {
+		first time.Weekday
+		days  int
+	}
+
+	layouts := map[layout]bool{}
+
+	for year := 2000; year < 2030; year++ {
+		for month := time.January; month <= time.December; month++ {
+			first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+			days := utils.LastOfMonth(first)
+			layouts[layout{first.Weekday(), days}] = true
+
+			for day := 1; day <= days; day++ {
+				tm := first.AddDate(0, 0, day-1)
+				// the weekday of the same month closest to the day
+				expected := 0
+
+				for d := 1; d <= days; d++ {
+					w := first.AddDate(0, 0, d-1).Weekday()
+
+					if w == time.Saturday || w == time.Sunday {
+						continue
+					}
+
+					if expected == 0 || abs(d-day) < abs(expected-day) {
+						expected = d
+					}
+				}
+
+				assert.Equal(expected, utils.NearestWeekday(tm), tm)
+			}
+		}
+	}
+
+	// 7 weekdays of the 1st times 28, 29, 30 and 31 days
+	assert.Len(layouts, 28)
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+
+	return n
+}
+
 func TestNthDayOfWeek(t *testing.T) {
 	assert := assert.New(t)
 
@@ -185,6 +296,10 @@ func TestNthDayOfWeek(t *testing.T) {
 		{time.Date(2022, 11, 3, 9, 0, 0, 0, time.UTC), time.Sunday, 2, 13},
 		{time.Date(2022, 11, 3, 9, 0, 0, 0, time.UTC), time.Sunday, 3, 20},
 		{time.Date(2022, 11, 3, 9, 0, 0, 0, time.UTC), time.Sunday, 4, 27},
+		// months with only four of the day of the week
+		{time.Date(2022, 10, 3, 9, 0, 0, 0, time.UTC), time.Tuesday, 5, 0},
+		{time.Date(2022, 11, 3, 9, 0, 0, 0, time.UTC), time.Monday, 5, 0},
+		{time.Date(2023, 2, 1, 9, 0, 0, 0, time.UTC), time.Monday, 5, 0},
 	}
 
 	for _, t := range tt {
@@ -0,0 +1,305 @@
+package cronparse
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/winebarrel/cronparse/utils"
+)
+
+var (
+	ErrDayFieldConflict = errors.New("exactly one of day-of-month and day-of-week must be '?'")
+)
+
+// field
+type Field int
+
+const (
+	FieldMinutes Field = iota
+	FieldHours
+	FieldDayOfMonth
+	FieldMonth
+	FieldDayOfWeek
+	FieldYear
+	FieldSeconds
+)
+
+var (
+	fieldNames  = []string{"Minutes", "Hours", "DayOfMonth", "Month", "DayOfWeek", "Year", "Seconds"}
+	fieldRanges = [][2]int{{0, 59}, {0, 23}, {1, 31}, {1, 12}, {1, 7}, {1970, 2199}, {0, 59}}
+)
+
+func (f Field) String() string {
+	if f < 0 || int(f) >= len(fieldNames) {
+		return fmt.Sprintf("Field(%d)", int(f))
+	}
+
+	return fieldNames[f]
+}
+
+func (f Field) Min() int {
+	return fieldRanges[f][0]
+}
+
+func (f Field) Max() int {
+	return fieldRanges[f][1]
+}
+
+// validation error
+type ValidationError struct {
+	Field   Field
+	Token   string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("%s: %q %s", e.Field, e.Token, e.Message)
+}
+
+func checkRange(f Field, x int, token string) error {
+	if x < f.Min() || f.Max() < x {
+		return &ValidationError{
+			Field:   f,
+			Token:   token,
+			Message: fmt.Sprintf("is out of range (%d-%d)", f.Min(), f.Max()),
+		}
+	}
+
+	return nil
+}
+
+// number
+func (v *Number) validate(f Field) error {
+	return checkRange(f, v.Value, v.String())
+}
+
+// number range
+func (v *NumberRange) validate(f Field) error {
+	if err := checkRange(f, v.From, v.String()); err != nil {
+		return err
+	}
+
+	if err := checkRange(f, v.To, v.String()); err != nil {
+		return err
+	}
+
+	// Other fields wrap around, but years do not
+	if f == FieldYear && v.From > v.To {
+		return &ValidationError{Field: f, Token: v.String(), Message: "must not be a reversed range"}
+	}
+
+	return nil
+}
+
+// increment
+func (v *Increment) validate(f Field) error {
+	if !v.Wildcard {
+		if err := checkRange(f, v.Top, v.String()); err != nil {
+			return err
+		}
+	}
+
+	if v.Buttom < 1 {
+		return &ValidationError{Field: f, Token: v.String(), Message: "must have a step of at least 1"}
+	}
+
+	return nil
+}
+
+// number range increment
+func (v *NumberRangeIncrement) validate(f Field) error {
+	if err := checkRange(f, v.From, v.String()); err != nil {
+		return err
+	}
+
+	if err := checkRange(f, v.To, v.String()); err != nil {
+		return err
+	}
+
+	return validateRangeIncrement(f, v.From, v.To, v.Buttom, v.String())
+}
+
+// week range increment
+func (v *WeekRangeIncrement) validate(f Field) error {
+	return validateRangeIncrement(f, weekNameToDayOfWeek(v.From), weekNameToDayOfWeek(v.To), v.Buttom, v.String())
+}
+
+// month range increment
+func (v *MonthRangeIncrement) validate(f Field) error {
+	return validateRangeIncrement(f, utils.MonthNameToNumber(v.From), utils.MonthNameToNumber(v.To), v.Buttom, v.String())
+}
+
+func validateRangeIncrement(f Field, from int, to int, buttom int, token string) error {
+	if buttom < 1 {
+		return &ValidationError{Field: f, Token: token, Message: "must have a step of at least 1"}
+	}
+
+	// Other fields wrap around, but years do not
+	if f == FieldYear && from > to {
+		return &ValidationError{Field: f, Token: token, Message: "must not be a reversed range"}
+	}
+
+	return nil
+}
+
+// last of month offset
+func (v *LastOfMonthOffset) validate(f Field) error {
+	if v.Offset < 1 || 30 < v.Offset {
+		return &ValidationError{Field: f, Token: v.String(), Message: "is out of range (L-1..L-30)"}
+	}
+
+	return nil
+}
+
+// weekday
+func (v *Weekday) validate(f Field) error {
+	return checkRange(f, v.Value, v.String())
+}
+
+// last instance
+func (v *LastInstance) validate(f Field) error {
+	if v.Name != "" {
+		return nil
+	}
+
+	return checkRange(f, v.DayOfWeek, v.String())
+}
+
+// instance
+func (v *Instance) validate(f Field) error {
+	if err := checkRange(f, v.DayOfWeek, v.String()); err != nil {
+		return err
+	}
+
+	if v.NthDayOfWeek < 1 || 5 < v.NthDayOfWeek {
+		return &ValidationError{Field: f, Token: v.String(), Message: "is out of range (#1-#5)"}
+	}
+
+	return nil
+}
+
+// common
+func (v *CommonExp) validate(f Field) error {
+	if v.NumberRangeIncrement != nil {
+		return v.NumberRangeIncrement.validate(f)
+	} else if v.Increment != nil {
+		return v.Increment.validate(f)
+	} else if v.NumberRange != nil {
+		return v.NumberRange.validate(f)
+	} else if v.Number != nil {
+		return v.Number.validate(f)
+	}
+
+	return nil
+}
+
+// day of month
+func (v *DayOfMonthExp) validate(f Field) error {
+	if v.CommonExp.Present() {
+		return v.CommonExp.validate(f)
+	} else if v.Weekday != nil {
+		return v.Weekday.validate(f)
+	} else if v.LastOffset != nil {
+		return v.LastOffset.validate(f)
+	}
+
+	return nil
+}
+
+// day of week
+func (v *DayOfWeekExp) validate(f Field) error {
+	if v.CommonExp.Present() {
+		return v.CommonExp.validate(f)
+	} else if v.LastInstance != nil {
+		return v.LastInstance.validate(f)
+	} else if v.Instance != nil {
+		return v.Instance.validate(f)
+	} else if v.NameRangeIncrement != nil {
+		return v.NameRangeIncrement.validate(f)
+	}
+
+	return nil
+}
+
+// month
+func (v *MonthExp) validate(f Field) error {
+	if v.CommonExp.Present() {
+		return v.CommonExp.validate(f)
+	} else if v.NameRangeIncrement != nil {
+		return v.NameRangeIncrement.validate(f)
+	}
+
+	return nil
+}
+
+func validateAny[T interface{ String() string }](f Field, exps []T, hasAny bool) error {
+	if hasAny && len(exps) > 1 {
+		strs := make([]string, 0, len(exps))
+
+		for _, e := range exps {
+			strs = append(strs, e.String())
+		}
+
+		return &ValidationError{Field: f, Token: strings.Join(strs, ","), Message: "must not combine '?' with other values"}
+	}
+
+	return nil
+}
+
+type validator interface {
+	validate(Field) error
+}
+
+func validateExps[T validator](f Field, exps []T) error {
+	for _, e := range exps {
+		if err := e.validate(f); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Validate checks every field of the expression against the limits of Amazon EventBridge.
+func (v *Expression) Validate() error {
+	if v.Seconds != nil {
+		if err := validateExps(FieldSeconds, v.Seconds.Exps); err != nil {
+			return err
+		}
+	}
+
+	errs := []error{
+		validateExps(FieldMinutes, v.Minutes.Exps),
+		validateExps(FieldHours, v.Hours.Exps),
+		validateExps(FieldDayOfMonth, v.DayOfMonth.Exps),
+		validateExps(FieldMonth, v.Month.Exps),
+		validateExps(FieldDayOfWeek, v.DayOfWeek.Exps),
+		validateExps(FieldYear, v.Year.Exps),
+	}
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := validateAny(FieldDayOfMonth, v.DayOfMonth.Exps, v.DayOfMonth.HasAny()); err != nil {
+		return err
+	}
+
+	if err := validateAny(FieldDayOfWeek, v.DayOfWeek.Exps, v.DayOfWeek.HasAny()); err != nil {
+		return err
+	}
+
+	if v.hasDayFieldConflict() {
+		return ErrDayFieldConflict
+	}
+
+	return nil
+}
+
+// Amazon EventBridge requires '?' in exactly one of the day-of-month and day-of-week fields.
+func (v *Expression) hasDayFieldConflict() bool {
+	return v.DayOfMonth.HasAny() == v.DayOfWeek.HasAny()
+}